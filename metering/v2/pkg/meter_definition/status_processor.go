@@ -89,6 +89,11 @@ func (u *StatusProcessor) Process(ctx context.Context, inObj *ObjectResourceMess
 			OnContinue(Call(func() (ClientAction, error) {
 				log.Info("found objs", "mdef", inObj.MeterDef)
 
+				// keep the last matched resources for the finalizer
+				if mdef.GetDeletionTimestamp() != nil {
+					return nil, nil
+				}
+
 				resources := []common.WorkloadResource{}
 
 				if inObj.Action == NewMeterDefAction {
@@ -232,6 +232,10 @@ func (s *MeterDefinitionStore) Add(obj interface{}) error {
 	logger.V(2).Info("adding obj")
 
 	if meterdef, ok := obj.(*v1beta1.MeterDefinition); ok {
+		if meterdef.GetDeletionTimestamp() != nil {
+			return s.handleDeletingMeterDefinition(meterdef)
+		}
+
 		return s.handleMeterDefinition(meterdef)
 	}
 
@@ -360,6 +364,21 @@ func (s *MeterDefinitionStore) handleMeterDefinition(meterdef *v1beta1.MeterDefi
 	return nil
 }
 
+// handleDeletingMeterDefinition removes a MeterDefinition that is waiting on its
+// finalizer so no new objects are matched to it.
+func (s *MeterDefinitionStore) handleDeletingMeterDefinition(meterdef *v1beta1.MeterDefinition) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.meterDefinitionFilters[MeterDefUID(meterdef.UID)]; !ok {
+		return nil
+	}
+
+	s.log.Info("removing deleted meterdef", "name", meterdef.Name, "namespace", meterdef.Namespace)
+	s.removeMeterDefinition(meterdef)
+	return nil
+}
+
 func (s *MeterDefinitionStore) addSeenObject(obj interface{}) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -19,6 +19,7 @@ import (
 	"os"
 
 	homedir "github.com/mitchellh/go-homedir"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/reporter/v2/cmd/reporter/register"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/reporter/v2/cmd/reporter/report"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/reporter/v2/cmd/reporter/sign"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/reporter/v2/cmd/reporter/verify"
@@ -45,6 +46,7 @@ func init() {
 	rootCmd.AddCommand(report.ReportCmd)
 	rootCmd.AddCommand(sign.SignCmd)
 	rootCmd.AddCommand(verify.VerifyCmd)
+	rootCmd.AddCommand(register.RegisterCmd)
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.cobra.yaml)")
 }
 
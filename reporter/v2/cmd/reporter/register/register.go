@@ -0,0 +1,98 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package register
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/marketplace"
+	"github.com/spf13/cobra"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("register_cmd")
+
+var requestFile, tokenFile, url string
+
+var RegisterCmd = &cobra.Command{
+	Use:   "register",
+	Short: "Process an offline registration request",
+	Long: `Process an offline registration request exported from a disconnected cluster.
+Takes the request, the pull secret token and prints the response to import on the cluster.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if requestFile == "" {
+			log.Error(errors.New("request not provided"), "request not provided")
+			os.Exit(1)
+		}
+
+		if tokenFile == "" {
+			log.Error(errors.New("token file not provided"), "token file not provided")
+			os.Exit(1)
+		}
+
+		request, err := ioutil.ReadFile(requestFile)
+		if err != nil {
+			log.Error(err, "Could not read request file")
+			os.Exit(1)
+		}
+
+		token, err := ioutil.ReadFile(tokenFile)
+		if err != nil {
+			log.Error(err, "Could not read token file")
+			os.Exit(1)
+		}
+
+		pullSecret := strings.TrimSpace(string(token))
+
+		tokenClaims, err := marketplace.GetJWTTokenClaim(pullSecret)
+		if err != nil {
+			log.Error(err, "Could not parse token")
+			os.Exit(1)
+		}
+
+		cfg := &config.OperatorConfig{
+			Marketplace: config.Marketplace{
+				URL: url,
+			},
+		}
+
+		mclient, err := marketplace.NewMarketplaceClientBuilder(cfg).NewMarketplaceClient(pullSecret, tokenClaims)
+		if err != nil {
+			log.Error(err, "Could not build marketplace client")
+			os.Exit(1)
+		}
+
+		response, err := mclient.ProcessRegistrationRequest(pullSecret, strings.TrimSpace(string(request)))
+		if err != nil {
+			log.Error(err, "Could not process registration request")
+			os.Exit(1)
+		}
+
+		fmt.Printf("%s", response)
+
+		os.Exit(0)
+	},
+}
+
+func init() {
+	RegisterCmd.Flags().StringVar(&requestFile, "request", "", "registration request file")
+	RegisterCmd.Flags().StringVar(&tokenFile, "tokenfile", "", "pull secret token file")
+	RegisterCmd.Flags().StringVar(&url, "url", "", "marketplace url, defaults to production")
+}
@@ -22,6 +22,7 @@ import (
 	"emperror.dev/errors"
 	"github.com/gotidy/ptr"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/reporter/v2/pkg/reporter"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/prometheus"
 	"github.com/spf13/cobra"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -30,7 +31,8 @@ var log = logf.Log.WithName("reporter_report_cmd")
 
 var name, namespace, cafile, tokenFile, uploadTarget, localFilePath string
 var local, upload bool
-var retry int
+var retry, maxQuerySeries, maxQuerySamples int
+var queryTimeout time.Duration
 
 var ReportCmd = &cobra.Command{
 	Use:   "report",
@@ -64,6 +66,11 @@ var ReportCmd = &cobra.Command{
 			Local:           local,
 			Upload:          upload,
 			UploaderTarget:  uploadTarget,
+			QueryLimits: prometheus.QueryLimits{
+				MaxSeries:  maxQuerySeries,
+				MaxSamples: maxQuerySamples,
+				Timeout:    queryTimeout,
+			},
 		}
 		cfg.SetDefaults()
 
@@ -98,4 +105,7 @@ func init() {
 	ReportCmd.Flags().BoolVar(&local, "local", false, "run locally")
 	ReportCmd.Flags().BoolVar(&upload, "upload", true, "to upload the payload")
 	ReportCmd.Flags().IntVar(&retry, "retry", 3, "number of retries")
+	ReportCmd.Flags().IntVar(&maxQuerySeries, "maxQuerySeries", 0, "max series a meter query may return, 0 to disable")
+	ReportCmd.Flags().IntVar(&maxQuerySamples, "maxQuerySamples", 0, "max samples a meter query may return, 0 to disable")
+	ReportCmd.Flags().DurationVar(&queryTimeout, "queryTimeout", 10*time.Second, "timeout for each prometheus query")
 }
@@ -37,6 +37,10 @@ var log = logf.Log.WithName("signer_sign_cmd")
 
 var f, publickey, privatekey, privatekeypassword string
 
+// wholeObject signs every field of the objects, as remote resources are
+// verified, instead of their GVK and spec.
+var wholeObject bool
+
 var SignCmd = &cobra.Command{
 	Use:   "sign",
 	Short: "Sign the yaml",
@@ -90,6 +94,14 @@ var SignCmd = &cobra.Command{
 		}
 
 		for i, uobj := range uobjs {
+			if wholeObject {
+				if err := signer.SignObject(&uobjs[i], pubKey, privKey); err != nil {
+					log.Error(err, "could not sign")
+					os.Exit(1)
+				}
+				continue
+			}
+
 			// Reduce Object to GVK+Spec and sign that content
 			bytes, err := signer.UnstructuredToGVKSpecBytes(uobj)
 			if err != nil {
@@ -144,4 +156,5 @@ func init() {
 	SignCmd.Flags().StringVar(&publickey, "publickey", "", "public key")
 	SignCmd.Flags().StringVar(&privatekey, "privatekey", "", "private key")
 	SignCmd.Flags().StringVar(&privatekeypassword, "privatekeypassword", "", "private key password")
+	SignCmd.Flags().BoolVar(&wholeObject, "whole-object", false, "sign the whole objects, as remote resources are verified")
 }
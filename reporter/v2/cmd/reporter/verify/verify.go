@@ -29,6 +29,10 @@ var log = logf.Log.WithName("signer_verify_cmd")
 
 var f, ca string
 
+// wholeObject verifies the objects are signed as a whole, as remote
+// resources are.
+var wholeObject bool
+
 var VerifyCmd = &cobra.Command{
 	Use:   "verify",
 	Short: "Verify the yaml",
@@ -65,7 +69,11 @@ var VerifyCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		err = signer.VerifySignatureArray(uobjs, caCert)
+		if wholeObject {
+			err = signer.VerifyObjectSignatureArray(uobjs, caCert)
+		} else {
+			err = signer.VerifySignatureArray(uobjs, caCert)
+		}
 		if err != nil {
 			fmt.Printf("yaml failed verification")
 			log.Error(err, "yaml failed verification")
@@ -79,4 +87,5 @@ var VerifyCmd = &cobra.Command{
 func init() {
 	VerifyCmd.Flags().StringVar(&f, "f", "", "input yaml file")
 	VerifyCmd.Flags().StringVar(&ca, "ca", "", "certificate authority file")
+	VerifyCmd.Flags().BoolVar(&wholeObject, "whole-object", false, "verify the whole objects are signed, as remote resources are")
 }
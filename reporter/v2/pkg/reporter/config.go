@@ -17,6 +17,7 @@ package reporter
 import (
 	"github.com/google/wire"
 	"github.com/gotidy/ptr"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -35,6 +36,7 @@ type Config struct {
 	TokenFile       string
 	Local           bool
 	Upload          bool
+	QueryLimits     prometheus.QueryLimits
 	UploaderTarget
 }
 
@@ -0,0 +1,234 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"context"
+	"time"
+
+	"emperror.dev/errors"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	. "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// dataSourceStep is the granularity the source of the report data is picked at.
+const dataSourceStep = time.Hour
+
+// RemoteStoreAPI queries the long-term store the metering series are remote
+// written to.
+type RemoteStoreAPI struct {
+	*PrometheusAPI
+}
+
+func provideRemoteStoreAPI(
+	ctx context.Context,
+	config *Config,
+	report *marketplacev1alpha1.MeterReport,
+	client client.Client,
+) (*RemoteStoreAPI, error) {
+	if report.Spec.RemoteStore == nil || config.Local {
+		return nil, nil
+	}
+
+	api, err := NewExternalPromAPI(ctx, client, report.Namespace, report.Spec.RemoteStore)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create remote store api")
+	}
+
+	return &RemoteStoreAPI{PrometheusAPI: api}, nil
+}
+
+// planDataSources splits the report into intervals and picks the source of
+// each. The local Prometheus is used whenever it has meter data for an
+// interval, the remote store otherwise.
+func (r *MarketplaceReporter) planDataSources(start, end time.Time) []marketplacev1alpha1.ReportDataSource {
+	if r.remoteStore == nil {
+		return nil
+	}
+
+	query := &MeterDataCoverageQuery{
+		Start: start.Add(dataSourceStep),
+		End:   end,
+		Step:  dataSourceStep,
+	}
+
+	local, err := r.QueryMeterDataCoverage(query)
+
+	if err != nil {
+		logger.Error(err, "failed to query local data coverage")
+	}
+
+	remote, err := r.remoteStore.QueryMeterDataCoverage(query)
+
+	if err != nil {
+		logger.Error(err, "failed to query remote store data coverage")
+	}
+
+	sources := []marketplacev1alpha1.ReportDataSource{}
+
+	for intervalStart := start; intervalStart.Before(end); intervalStart = intervalStart.Add(dataSourceStep) {
+		intervalEnd := intervalStart.Add(dataSourceStep)
+		key := model.TimeFromUnixNano(intervalEnd.UnixNano())
+
+		if intervalEnd.After(end) {
+			intervalEnd = end
+		}
+
+		source := marketplacev1alpha1.ReportDataSourceMissing
+
+		switch {
+		case local[key]:
+			source = marketplacev1alpha1.ReportDataSourceLocal
+		case remote[key]:
+			source = marketplacev1alpha1.ReportDataSourceRemoteWrite
+		}
+
+		if last := len(sources) - 1; last >= 0 && sources[last].Source == source {
+			sources[last].End = metav1.NewTime(intervalEnd)
+			continue
+		}
+
+		sources = append(sources, marketplacev1alpha1.ReportDataSource{
+			Start:  metav1.NewTime(intervalStart),
+			End:    metav1.NewTime(intervalEnd),
+			Source: source,
+		})
+	}
+
+	logger.Info("planned report data sources", "sources", sources)
+	return sources
+}
+
+// usesRemoteStore returns true if any interval of the report is served by
+// the remote store.
+func (r *MarketplaceReporter) usesRemoteStore() bool {
+	if r.remoteStore == nil {
+		return false
+	}
+
+	for _, source := range r.dataSources {
+		if source.Source == marketplacev1alpha1.ReportDataSourceRemoteWrite {
+			return true
+		}
+	}
+
+	return false
+}
+
+// queryMeterDefinitions adds the meter definitions found in the remote store
+// to the local ones.
+func (r *MarketplaceReporter) queryMeterDefinitions(query *MeterDefinitionQuery) (model.Value, v1.Warnings, error) {
+	result, warnings, err := r.QueryMeterDefinitions(query)
+
+	if err != nil || !r.usesRemoteStore() {
+		return result, warnings, err
+	}
+
+	remote, remoteWarnings, err := r.remoteStore.QueryMeterDefinitions(query)
+	warnings = append(warnings, remoteWarnings...)
+
+	if err != nil {
+		return nil, warnings, errors.Wrap(err, "failed to query remote store")
+	}
+
+	localMatrix, ok := result.(model.Matrix)
+	remoteMatrix, remoteOk := remote.(model.Matrix)
+
+	if !ok || !remoteOk {
+		return result, warnings, nil
+	}
+
+	return MergeMatrices(localMatrix, remoteMatrix), warnings, nil
+}
+
+// sourceReportQuery runs the meter query against the source of each interval
+// and merges the results. The remote store only holds the recorded meter
+// series, so it is read with the recorded query.
+func (r *MarketplaceReporter) sourceReportQuery(query *PromQuery) (model.Value, v1.Warnings, error) {
+	if !r.usesRemoteStore() {
+		return r.reportQuery(query)
+	}
+
+	result := model.Matrix{}
+	var warnings v1.Warnings
+
+	for i, source := range r.dataSources {
+		sub, ok := subQuery(query, source.Start.Time, source.End.Time, i == len(r.dataSources)-1)
+
+		if !ok {
+			continue
+		}
+
+		var val model.Value
+		var subWarnings v1.Warnings
+		var err error
+
+		switch source.Source {
+		case marketplacev1alpha1.ReportDataSourceRemoteWrite:
+			val, subWarnings, err = r.remoteStore.RecordedReportQuery(sub)
+		default:
+			val, subWarnings, err = r.reportQuery(sub)
+		}
+
+		warnings = append(warnings, subWarnings...)
+
+		if err != nil {
+			return nil, warnings, errors.WrapWithDetails(err, "failed to query interval",
+				"source", source.Source, "start", source.Start, "end", source.End)
+		}
+
+		matrix, ok := val.(model.Matrix)
+
+		if !ok {
+			return nil, warnings, errors.NewWithDetails("result type is unprocessable", "type", val.Type().String())
+		}
+
+		result = MergeMatrices(result, matrix)
+	}
+
+	return result, warnings, nil
+}
+
+// subQuery limits the query to the steps within the interval. The end of the
+// interval is only included for the last interval.
+func subQuery(query *PromQuery, start, end time.Time, last bool) (*PromQuery, bool) {
+	subStart := query.Start
+
+	if start.After(subStart) {
+		steps := (start.Sub(query.Start) + query.Step - 1) / query.Step
+		subStart = query.Start.Add(steps * query.Step)
+	}
+
+	subEnd := query.End
+
+	if !last && !end.After(subEnd) {
+		subEnd = end.Add(-time.Second)
+	}
+
+	if subStart.After(subEnd) {
+		return nil, false
+	}
+
+	args := *query.PromQueryArgs
+	args.Start = subStart
+	args.End = subEnd
+
+	return &PromQuery{PromQueryArgs: &args}, true
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/gotidy/ptr"
+	"github.com/prometheus/common/model"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("data sources", func() {
+	var (
+		sut           *MarketplaceReporter
+		start         = time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+		end           = start.Add(4 * time.Hour)
+		localQueries  []url.Values
+		remoteQueries []url.Values
+	)
+
+	// serves the coverage query with a sample at the end of every covered
+	// hour and the meter queries with a sample at every step
+	dataSourceRoundTripper := func(queries *[]url.Values, covered func(time.Time) bool) RoundTripFunc {
+		return func(req *http.Request) *http.Response {
+			defer req.Body.Close()
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).To(Succeed())
+
+			query, err := url.ParseQuery(string(body))
+			Expect(err).To(Succeed())
+			*queries = append(*queries, query)
+
+			queryStart, _ := strconv.ParseFloat(query.Get("start"), 64)
+			queryEnd, _ := strconv.ParseFloat(query.Get("end"), 64)
+			step, _ := strconv.ParseFloat(query.Get("step"), 64)
+
+			values := [][]interface{}{}
+			for t := queryStart; t <= queryEnd; t += step {
+				if covered(time.Unix(int64(t), 0).UTC()) {
+					values = append(values, []interface{}{t, "1"})
+				}
+			}
+
+			data, _ := json.Marshal(map[string]interface{}{
+				"status": "success",
+				"data": map[string]interface{}{
+					"resultType": "matrix",
+					"result": []map[string]interface{}{
+						{"metric": map[string]string{"pod": "example-app-pod"}, "values": values},
+					},
+				},
+			})
+
+			headers := make(http.Header)
+			headers.Add("content-type", "application/json")
+
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(string(data))),
+				Header:     headers,
+			}
+		}
+	}
+
+	// the local prometheus lost its data for the last 2 hours
+	localCovered := func(t time.Time) bool {
+		return !t.After(start.Add(2 * time.Hour))
+	}
+
+	remoteCovered := func(t time.Time) bool {
+		return true
+	}
+
+	BeforeEach(func() {
+		localQueries = []url.Values{}
+		remoteQueries = []url.Values{}
+
+		sut = &MarketplaceReporter{
+			PrometheusAPI: prometheus.PrometheusAPI{API: getTestAPI(dataSourceRoundTripper(&localQueries, localCovered))},
+			remoteStore: &RemoteStoreAPI{
+				PrometheusAPI: &prometheus.PrometheusAPI{API: getTestAPI(dataSourceRoundTripper(&remoteQueries, remoteCovered))},
+			},
+			Config: &Config{Retry: ptr.Int(0)},
+			report: &marketplacev1alpha1.MeterReport{
+				Spec: marketplacev1alpha1.MeterReportSpec{
+					StartTime: metav1.Time{Time: start},
+					EndTime:   metav1.Time{Time: end},
+				},
+			},
+		}
+	})
+
+	It("should use the remote store for intervals missing locally", func() {
+		sources := sut.planDataSources(start, end)
+
+		Expect(sources).To(Equal([]marketplacev1alpha1.ReportDataSource{
+			{
+				Start:  metav1.NewTime(start),
+				End:    metav1.NewTime(start.Add(2 * time.Hour)),
+				Source: marketplacev1alpha1.ReportDataSourceLocal,
+			},
+			{
+				Start:  metav1.NewTime(start.Add(2 * time.Hour)),
+				End:    metav1.NewTime(end),
+				Source: marketplacev1alpha1.ReportDataSourceRemoteWrite,
+			},
+		}))
+	})
+
+	It("should not plan sources without a remote store", func() {
+		sut.remoteStore = nil
+
+		Expect(sut.planDataSources(start, end)).To(BeNil())
+		Expect(localQueries).To(BeEmpty())
+	})
+
+	It("should merge the meter query results of each source", func() {
+		sut.dataSources = sut.planDataSources(start, end)
+		localQueries = []url.Values{}
+		remoteQueries = []url.Values{}
+
+		query := prometheus.NewPromQuery(&prometheus.PromQueryArgs{
+			Type:          v1beta1.WorkloadTypePod,
+			MeterDef:      types.NamespacedName{Name: "foo", Namespace: "bar"},
+			Metric:        "rpc_durations_seconds_count",
+			Query:         "my_query",
+			Start:         start,
+			End:           end,
+			Step:          time.Hour,
+			AggregateFunc: "sum",
+		})
+
+		val, _, err := sut.sourceReportQuery(query)
+		Expect(err).To(Succeed())
+
+		matrix := val.(model.Matrix)
+		Expect(matrix).To(HaveLen(1))
+
+		timestamps := []time.Time{}
+		for _, pair := range matrix[0].Values {
+			timestamps = append(timestamps, pair.Timestamp.Time().UTC())
+		}
+
+		Expect(timestamps).To(Equal([]time.Time{
+			start,
+			start.Add(time.Hour),
+			start.Add(2 * time.Hour),
+			start.Add(3 * time.Hour),
+			start.Add(4 * time.Hour),
+		}))
+
+		Expect(remoteQueries).To(HaveLen(1))
+		Expect(remoteQueries[0].Get("query")).To(HavePrefix("max without"))
+		Expect(remoteQueries[0].Get("query")).To(ContainSubstring(prometheus.RecordedMeterName))
+		Expect(remoteQueries[0].Get("start")).To(Equal(strconv.FormatInt(start.Add(2*time.Hour).Unix(), 10)))
+	})
+})
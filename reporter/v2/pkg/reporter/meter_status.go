@@ -0,0 +1,192 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prometheus/common/model"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type meterStatKey struct {
+	uid       string
+	namespace string
+	name      string
+	metricID  string
+}
+
+// meterStat is what a single collection observed for one meter.
+type meterStat struct {
+	seriesCount   int
+	lastTimestamp model.Time
+	lastValue     model.SampleValue
+	queryError    error
+}
+
+// meterStats collects per meter results while CollectMetrics runs so they
+// can be written back to the MeterDefinition status.
+type meterStats struct {
+	mutex sync.Mutex
+	stats map[meterStatKey]*meterStat
+}
+
+func newMeterStats() *meterStats {
+	return &meterStats{
+		stats: make(map[meterStatKey]*meterStat),
+	}
+}
+
+func (s *meterStats) get(mdef *meterDefPromQuery) *meterStat {
+	key := meterStatKey{
+		uid:       mdef.uid,
+		namespace: mdef.meterDefLabel.MeterDefNamespace,
+		name:      mdef.meterDefLabel.MeterDefName,
+		metricID:  mdef.label,
+	}
+
+	stat, ok := s.stats[key]
+
+	if !ok {
+		stat = &meterStat{}
+		s.stats[key] = stat
+	}
+
+	return stat
+}
+
+func (s *meterStats) recordQueryError(mdef *meterDefPromQuery, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.get(mdef).queryError = err
+}
+
+// recordMatrix adds the series of a query result. The last value is the sum
+// of every series at the most recent timestamp.
+func (s *meterStats) recordMatrix(mdef *meterDefPromQuery, matrix model.Matrix) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stat := s.get(mdef)
+	stat.seriesCount = stat.seriesCount + len(matrix)
+
+	for _, stream := range matrix {
+		if len(stream.Values) == 0 {
+			continue
+		}
+
+		pair := stream.Values[len(stream.Values)-1]
+
+		switch {
+		case pair.Timestamp.After(stat.lastTimestamp):
+			stat.lastTimestamp = pair.Timestamp
+			stat.lastValue = pair.Value
+		case pair.Timestamp.Equal(stat.lastTimestamp):
+			stat.lastValue = stat.lastValue + pair.Value
+		}
+	}
+}
+
+// MeterDefinitions returns the MeterDefinitions that had meters queried.
+func (s *meterStats) MeterDefinitions() []types.NamespacedName {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	found := map[types.NamespacedName]bool{}
+	names := []types.NamespacedName{}
+
+	for key := range s.stats {
+		name := types.NamespacedName{Namespace: key.namespace, Name: key.name}
+
+		if found[name] {
+			continue
+		}
+
+		found[name] = true
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		return names[i].String() < names[j].String()
+	})
+
+	return names
+}
+
+// UpdateStatus merges the collected stats into the meters status of the
+// MeterDefinition. Stats from a MeterDefinition with a different UID, i.e.
+// one that was deleted and recreated, are ignored.
+func (s *meterStats) UpdateStatus(
+	meterdef *marketplacev1alpha1.MeterDefinition,
+	reportTime metav1.Time,
+) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for key, stat := range s.stats {
+		if key.namespace != meterdef.Namespace ||
+			key.name != meterdef.Name ||
+			key.uid != string(meterdef.UID) {
+			continue
+		}
+
+		var meter *common.MeterStatus
+
+		for i := range meterdef.Status.Meters {
+			if meterdef.Status.Meters[i].MetricID == key.metricID {
+				meter = &meterdef.Status.Meters[i]
+				break
+			}
+		}
+
+		if meter == nil {
+			meterdef.Status.Meters = append(meterdef.Status.Meters, common.MeterStatus{MetricID: key.metricID})
+			meter = &meterdef.Status.Meters[len(meterdef.Status.Meters)-1]
+		}
+
+		stat.updateMeterStatus(meter, reportTime)
+	}
+
+	sort.Slice(meterdef.Status.Meters, func(i, j int) bool {
+		return meterdef.Status.Meters[i].MetricID < meterdef.Status.Meters[j].MetricID
+	})
+}
+
+func (stat *meterStat) updateMeterStatus(meter *common.MeterStatus, reportTime metav1.Time) {
+	switch {
+	case stat.queryError != nil:
+		cond := common.MeterConditionQueryError
+		cond.Message = stat.queryError.Error()
+
+		meter.SeriesCount = 0
+		meter.LastQueryError = stat.queryError.Error()
+		meter.Conditions.SetCondition(cond)
+	case stat.seriesCount == 0:
+		meter.SeriesCount = 0
+		meter.LastQueryError = ""
+		meter.Conditions.SetCondition(common.MeterConditionNoData)
+	default:
+		meter.SeriesCount = stat.seriesCount
+		meter.LastValue = stat.lastValue.String()
+		meter.LastReportTime = reportTime.DeepCopy()
+		meter.LastQueryError = ""
+		meter.Conditions.SetCondition(common.MeterConditionHealthy)
+	}
+}
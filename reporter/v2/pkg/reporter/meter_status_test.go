@@ -0,0 +1,125 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reporter
+
+import (
+	"time"
+
+	"emperror.dev/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/common/model"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("meterStats", func() {
+	var (
+		sut        *meterStats
+		meterdef   *marketplacev1alpha1.MeterDefinition
+		reportTime metav1.Time
+
+		newQuery = func(metric string) *meterDefPromQuery {
+			return &meterDefPromQuery{
+				uid:   "a",
+				label: metric,
+				meterDefLabel: &common.MeterDefPrometheusLabels{
+					UID:               "a",
+					MeterDefName:      "foo",
+					MeterDefNamespace: "bar",
+					Metric:            metric,
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		sut = newMeterStats()
+		reportTime = metav1.Now()
+		meterdef = &marketplacev1alpha1.MeterDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "bar",
+				UID:       types.UID("a"),
+			},
+		}
+	})
+
+	It("should record healthy, empty and failed meters", func() {
+		now := model.TimeFromUnix(time.Now().Unix())
+
+		sut.recordMatrix(newQuery("healthy"), model.Matrix{
+			{Values: []model.SamplePair{{Timestamp: now.Add(-time.Hour), Value: 1}, {Timestamp: now, Value: 2}}},
+			{Values: []model.SamplePair{{Timestamp: now, Value: 3}}},
+		})
+		sut.recordMatrix(newQuery("empty"), model.Matrix{})
+		sut.recordQueryError(newQuery("failed"), errors.New("bad query"))
+
+		Expect(sut.MeterDefinitions()).To(ConsistOf(types.NamespacedName{Namespace: "bar", Name: "foo"}))
+
+		sut.UpdateStatus(meterdef, reportTime)
+
+		meters := meterdef.Status.Meters
+		Expect(meters).To(HaveLen(3))
+
+		Expect(meters[0].MetricID).To(Equal("empty"))
+		Expect(meters[0].SeriesCount).To(Equal(0))
+		Expect(meters[0].LastReportTime).To(BeNil())
+		Expect(meters[0].Conditions.GetCondition(common.MeterConditionTypeHealthy).Reason).To(Equal(common.MeterConditionReasonNoData))
+
+		Expect(meters[1].MetricID).To(Equal("failed"))
+		Expect(meters[1].LastQueryError).To(Equal("bad query"))
+		Expect(meters[1].Conditions.GetCondition(common.MeterConditionTypeHealthy).Reason).To(Equal(common.MeterConditionReasonQueryError))
+
+		Expect(meters[2].MetricID).To(Equal("healthy"))
+		Expect(meters[2].SeriesCount).To(Equal(2))
+		Expect(meters[2].LastValue).To(Equal("5"))
+		Expect(meters[2].LastReportTime).To(Equal(&reportTime))
+		Expect(meters[2].Conditions.IsTrueFor(common.MeterConditionTypeHealthy)).To(BeTrue())
+	})
+
+	It("should keep the last report time when a meter stops returning data", func() {
+		lastReport := metav1.NewTime(reportTime.Add(-time.Hour))
+		meterdef.Status.Meters = []common.MeterStatus{
+			{
+				MetricID:       "healthy",
+				LastReportTime: &lastReport,
+				LastValue:      "5",
+				SeriesCount:    2,
+			},
+		}
+
+		sut.recordMatrix(newQuery("healthy"), model.Matrix{})
+		sut.UpdateStatus(meterdef, reportTime)
+
+		meter := meterdef.Status.Meters[0]
+		Expect(meter.LastReportTime).To(Equal(&lastReport))
+		Expect(meter.LastValue).To(Equal("5"))
+		Expect(meter.SeriesCount).To(Equal(0))
+		Expect(meter.Conditions.GetCondition(common.MeterConditionTypeHealthy).Status).To(Equal(corev1.ConditionFalse))
+	})
+
+	It("should ignore stats for a recreated meterdefinition", func() {
+		sut.recordMatrix(newQuery("healthy"), model.Matrix{})
+		meterdef.UID = types.UID("b")
+
+		sut.UpdateStatus(meterdef, reportTime)
+
+		Expect(meterdef.Status.Meters).To(BeEmpty())
+	})
+})
@@ -67,6 +67,9 @@ type MarketplaceReporter struct {
 	mktconfig         *marketplacev1alpha1.MarketplaceConfig
 	report            *marketplacev1alpha1.MeterReport
 	prometheusService *corev1.Service
+	meterStats        *meterStats
+	remoteStore       *RemoteStoreAPI
+	dataSources       []marketplacev1alpha1.ReportDataSource
 	*Config
 }
 
@@ -79,14 +82,23 @@ func NewMarketplaceReporter(
 	mktconfig *marketplacev1alpha1.MarketplaceConfig,
 	prometheusService *corev1.Service,
 	api *PrometheusAPI,
+	remoteStore *RemoteStoreAPI,
 ) (*MarketplaceReporter, error) {
+	promAPI := *api
+	promAPI.Limits = config.QueryLimits
+
+	if remoteStore != nil {
+		remoteStore.Limits = config.QueryLimits
+	}
+
 	return &MarketplaceReporter{
-		PrometheusAPI:     *api,
+		PrometheusAPI:     promAPI,
 		k8sclient:         k8sclient,
 		mktconfig:         mktconfig,
 		report:            report,
 		Config:            config,
 		prometheusService: prometheusService,
+		remoteStore:       remoteStore,
 	}, nil
 }
 
@@ -99,6 +111,9 @@ func (r *MarketplaceReporter) CollectMetrics(ctxIn context.Context) (map[string]
 	resultsMap := make(map[string]*MetricBase)
 	var resultsMapMutex sync.Mutex
 
+	r.meterStats = newMeterStats()
+	r.dataSources = r.planDataSources(r.report.Spec.StartTime.Time, r.report.Spec.EndTime.Time)
+
 	errorList := []error{}
 
 	// data channels ; closed by this func
@@ -221,7 +236,7 @@ func (r *MarketplaceReporter) RetrieveMeterDefinitions(
 		q, _ := query.Print()
 		logger.Info("output", "query", q)
 
-		result, warnings, err = r.QueryMeterDefinitions(query)
+		result, warnings, err = r.queryMeterDefinitions(query)
 
 		if err != nil {
 			logger.Error(err, "querying prometheus", "warnings", warnings)
@@ -280,10 +295,17 @@ func (r *MarketplaceReporter) Query(
 		query := mdef.query
 		var val model.Value
 		var warnings v1.Warnings
+		var limitErr error
 
 		err := utils.Retry(func() error {
 			var err error
-			val, warnings, err = r.ReportQuery(query)
+			val, warnings, err = r.sourceReportQuery(query)
+
+			if errors.Is(err, QueryLimitExceeded) {
+				// the cost of the query won't change on a retry
+				limitErr = err
+				return nil
+			}
 
 			if err != nil {
 				return errors.Wrap(err, "error with query")
@@ -292,12 +314,20 @@ func (r *MarketplaceReporter) Query(
 			return nil
 		}, *r.Retry)
 
+		if limitErr != nil {
+			logger.Error(limitErr, "query rejected", "meterdef", query.MeterDef, "metric", query.Metric)
+			r.meterStats.recordQueryError(mdef, limitErr)
+			errorsch <- limitErr
+			return
+		}
+
 		if warnings != nil {
 			logger.Info("warnings %v", warnings)
 		}
 
 		if err != nil {
 			logger.Error(err, "error encountered")
+			r.meterStats.recordQueryError(mdef, err)
 			errorsch <- err
 			return
 		}
@@ -318,6 +348,31 @@ func (r *MarketplaceReporter) Query(
 	})
 }
 
+// reportQuery reads the meter from its recorded series and falls back to the
+// raw query if the recording does not cover the report. The cost of each
+// query is checked before it is run.
+func (r *MarketplaceReporter) reportQuery(query *PromQuery) (model.Value, v1.Warnings, error) {
+	if err := r.CheckRecordedQueryCost(query); err != nil {
+		return nil, nil, err
+	}
+
+	val, warnings, err := r.RecordedReportQuery(query)
+
+	if err == nil && IsRecordedFor(query, val) {
+		return val, warnings, nil
+	}
+
+	if err != nil {
+		logger.Info("failed to query recorded series, using raw query", "metric", query.Metric, "err", err.Error())
+	}
+
+	if err := r.CheckQueryCost(query); err != nil {
+		return nil, nil, err
+	}
+
+	return r.ReportQuery(query)
+}
+
 func (r *MarketplaceReporter) Process(
 	ctx context.Context,
 	inPromModels <-chan meterDefPromModel,
@@ -335,6 +390,7 @@ func (r *MarketplaceReporter) Process(
 		switch m.Type() {
 		case model.ValMatrix:
 			matrixVals := m.(model.Matrix)
+			r.meterStats.recordMatrix(pmodel.mdef, matrixVals)
 
 			for _, matrix := range matrixVals {
 				logger.V(4).Info("adding metric", "metric", matrix.Metric)
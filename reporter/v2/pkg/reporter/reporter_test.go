@@ -16,6 +16,7 @@ package reporter
 
 import (
 	"bytes"
+	"errors"
 
 	"context"
 	"encoding/json"
@@ -329,6 +330,7 @@ var _ = Describe("Reporter", func() {
 			Expect(errs).To(BeEmpty())
 			Expect(results).ToNot(BeEmpty())
 			Expect(len(results)).To(Equal(1488))
+			Expect(sut.meterStats.MeterDefinitions()).To(ConsistOf(types.NamespacedName{Namespace: "bar", Name: "foo"}))
 
 			By("writing report")
 
@@ -403,6 +405,29 @@ var _ = Describe("Reporter", func() {
 
 			close(done)
 		}, 20)
+
+		It("should reject queries over the limits", func(done Done) {
+			sut.PrometheusAPI.Limits = prometheus.QueryLimits{MaxSeries: 1}
+
+			results, errs, err := sut.CollectMetrics(context.TODO())
+
+			Expect(err).ToNot(Succeed())
+			Expect(errs).To(HaveLen(2))
+			Expect(errors.Is(errs[0], prometheus.QueryLimitExceeded)).To(BeTrue())
+			Expect(results).To(BeEmpty())
+
+			meterdef := &marketplacev1alpha1.MeterDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar", UID: types.UID("a")},
+			}
+			sut.meterStats.UpdateStatus(meterdef, metav1.Now())
+
+			Expect(meterdef.Status.Meters).To(HaveLen(2))
+			for _, meter := range meterdef.Status.Meters {
+				Expect(meter.Conditions.GetCondition(common.MeterConditionTypeHealthy).Reason).To(Equal(common.MeterConditionReasonQueryError))
+			}
+
+			close(done)
+		}, 20)
 	})
 })
 
@@ -432,6 +457,15 @@ func mockResponseRoundTripper(file string, meterdefinitions []v1beta1.MeterDefin
 		headers := make(http.Header)
 		headers.Add("content-type", "application/json")
 
+		// instant queries are only used to estimate query cost
+		if req.URL.String() == "http://localhost:9090/api/v1/query" {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1,"2"]}]}}`)),
+				Header:     headers,
+			}
+		}
+
 		Expect(req.URL.String()).To(Equal("http://localhost:9090/api/v1/query_range"), "url does not match expected")
 
 		fileBytes, err := ioutil.ReadFile(file)
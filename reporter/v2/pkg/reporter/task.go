@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
 
 	"emperror.dev/errors"
 	"github.com/google/uuid"
@@ -28,6 +29,7 @@ import (
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
 	. "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/reconcileutils"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -90,6 +92,9 @@ func (r *Task) Run() error {
 
 	logger.Info("tarring", "outputfile", fileName)
 
+	// uploadID is the receipt of the upload, it is only set once uploaded
+	var uploadID *types.UID
+
 	if r.Config.Upload {
 		err = r.Uploader.UploadFile(fileName)
 
@@ -98,6 +103,9 @@ func (r *Task) Run() error {
 		}
 
 		logger.Info("uploaded metrics", "metricsLength", len(metrics))
+
+		id := types.UID(reportID.String())
+		uploadID = &id
 	}
 
 	report := &marketplacev1alpha1.MeterReport{}
@@ -115,6 +123,12 @@ func (r *Task) Run() error {
 						report.Status.QueryErrorList = append(report.Status.QueryErrorList, err.Error())
 					}
 
+					report.Status.DataSources = reporter.dataSources
+
+					if uploadID != nil {
+						report.Status.UploadID = uploadID
+					}
+
 					return UpdateAction(report, UpdateStatusOnly(true)), nil
 				})),
 			),
@@ -131,17 +145,119 @@ func (r *Task) Run() error {
 		log.Error(err, "failed to update report status")
 	}
 
+	for _, name := range reporter.meterStats.MeterDefinitions() {
+		err = updateMeterDefinitionStatus(r.Ctx, r.CC, name, reporter.meterStats, reporter.report.Spec.EndTime)
+
+		if err != nil {
+			log.Error(err, "failed to update meterdefinition status", "name", name)
+		}
+	}
+
 	return nil
 }
 
-func providePrometheusSetup(config *Config, report *marketplacev1alpha1.MeterReport, promService *corev1.Service) *PrometheusAPISetup {
-	return &PrometheusAPISetup{
+func updateMeterDefinitionStatus(
+	ctx context.Context,
+	cc ClientCommandRunner,
+	name types.NamespacedName,
+	stats *meterStats,
+	reportTime metav1.Time,
+) error {
+	return utils.Retry(func() error {
+		meterdef := &marketplacev1alpha1.MeterDefinition{}
+
+		result, _ := cc.Do(
+			ctx,
+			HandleResult(
+				GetAction(name, meterdef),
+				OnContinue(Call(func() (ClientAction, error) {
+					stats.UpdateStatus(meterdef, reportTime)
+					return UpdateAction(meterdef, UpdateStatusOnly(true)), nil
+				})),
+			),
+		)
+
+		if result.Is(Error) {
+			return result
+		}
+
+		return nil
+	}, 3)
+}
+
+func providePrometheusSetup(
+	ctx context.Context,
+	config *Config,
+	report *marketplacev1alpha1.MeterReport,
+	promService *corev1.Service,
+	client client.Client,
+) (*PrometheusAPISetup, error) {
+	setup := &PrometheusAPISetup{
 		Report:        report,
 		PromService:   promService,
 		CertFilePath:  config.CaFile,
 		TokenFilePath: config.TokenFile,
 		RunLocal:      config.Local,
 	}
+
+	if report.Spec.ExternalPrometheus != nil && !config.Local {
+		external, err := LoadExternalPrometheusConfig(ctx, client, report.Namespace, report.Spec.ExternalPrometheus)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load external prometheus config")
+		}
+
+		setup.External = external
+	}
+
+	if setup.External == nil && !config.Local && promService != nil {
+		setup.Replicas = getPrometheusReplicas(ctx, client, promService)
+	}
+
+	return setup, nil
+}
+
+// getPrometheusReplicas returns the addresses of the ready Prometheus pods
+// behind the service, sorted by pod name. A failed lookup falls back to
+// querying the service.
+func getPrometheusReplicas(
+	ctx context.Context,
+	client client.Client,
+	promService *corev1.Service,
+) []string {
+	endpoints := &corev1.Endpoints{}
+
+	err := client.Get(ctx, types.NamespacedName{Name: promService.Name, Namespace: promService.Namespace}, endpoints)
+	if err != nil {
+		logger.Info("failed to get prometheus endpoints, querying the service", "err", err)
+		return nil
+	}
+
+	addresses := []corev1.EndpointAddress{}
+	for _, subset := range endpoints.Subsets {
+		addresses = append(addresses, subset.Addresses...)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return endpointAddressName(addresses[i]) < endpointAddressName(addresses[j])
+	})
+
+	replicas := []string{}
+	for _, address := range addresses {
+		if !utils.Contains(replicas, address.IP) {
+			replicas = append(replicas, address.IP)
+		}
+	}
+
+	logger.Info("retrieved prometheus replicas", "replicas", len(replicas))
+	return replicas
+}
+
+func endpointAddressName(address corev1.EndpointAddress) string {
+	if address.TargetRef != nil {
+		return address.TargetRef.Name
+	}
+
+	return address.IP
 }
 
 func getClientOptions() managers.ClientOptions {
@@ -190,6 +306,11 @@ func getPrometheusService(
 ) (service *corev1.Service, returnErr error) {
 	service = &corev1.Service{}
 
+	if report.Spec.ExternalPrometheus != nil {
+		logger.Info("using external prometheus", "url", report.Spec.ExternalPrometheus.URL)
+		return nil, nil
+	}
+
 	if report.Spec.PrometheusService == nil {
 		returnErr = errors.New("cannot retrieve service as the report doesn't have a value for it")
 		return
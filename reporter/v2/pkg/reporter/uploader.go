@@ -32,10 +32,9 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/gotidy/ptr"
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
-	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/prometheus"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/transport"
 	. "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/reconcileutils"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/version"
-	"golang.org/x/net/http2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/jsonpath"
@@ -81,11 +80,12 @@ type Uploader interface {
 }
 
 type RedHatInsightsUploaderConfig struct {
-	URL                 string   `json:"url"`
-	Token               string   `json:"-"`
-	OperatorVersion     string   `json:"operatorVersion"`
-	ClusterID           string   `json:"clusterID"`
-	AdditionalCertFiles []string `json:"additionalCertFiles,omitempty"`
+	URL                 string           `json:"url"`
+	Token               string           `json:"-"`
+	OperatorVersion     string           `json:"operatorVersion"`
+	ClusterID           string           `json:"clusterID"`
+	AdditionalCertFiles []string         `json:"additionalCertFiles,omitempty"`
+	Outbound            transport.Config `json:"-"`
 	httpVersion         *int
 }
 
@@ -99,11 +99,8 @@ var _ Uploader = &RedHatInsightsUploader{}
 func NewRedHatInsightsUploader(
 	config *RedHatInsightsUploaderConfig,
 ) (Uploader, error) {
-	tlsConfig, err := prometheus.GenerateCACertPool(config.AdditionalCertFiles...)
-
-	if err != nil {
-		return nil, err
-	}
+	outbound := config.Outbound
+	outbound.CAFiles = append(append([]string{}, outbound.CAFiles...), config.AdditionalCertFiles...)
 
 	client := &http.Client{}
 
@@ -115,13 +112,17 @@ func NewRedHatInsightsUploader(
 	// Use the proper transport in the client
 	switch *config.httpVersion {
 	case 1:
-		client.Transport = &http.Transport{
-			TLSClientConfig: tlsConfig,
+		rt, err := outbound.NewTransport()
+		if err != nil {
+			return nil, err
 		}
+		client.Transport = rt
 	case 2:
-		client.Transport = &http2.Transport{
-			TLSClientConfig: tlsConfig,
+		rt, err := outbound.NewHTTP2Transport()
+		if err != nil {
+			return nil, err
 		}
+		client.Transport = rt
 	}
 
 	return &RedHatInsightsUploader{
@@ -344,10 +345,27 @@ func provideProductionInsightsConfig(
 
 	cloudToken := buf.String()
 
+	outbound, err := transport.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	if !outbound.HasProxy() {
+		proxy := &openshiftconfigv1.Proxy{}
+		result, _ := cc.Do(ctx, GetAction(types.NamespacedName{
+			Name: transport.ClusterProxyName,
+		}, proxy))
+
+		if result.Is(Continue) {
+			outbound.SetClusterProxy(proxy)
+		}
+	}
+
 	return &RedHatInsightsUploaderConfig{
 		URL:             "https://cloud.redhat.com",
 		ClusterID:       string(clusterVersion.Spec.ClusterID), // get from cluster
 		OperatorVersion: version.Version,
 		Token:           cloudToken, // get from secret
+		Outbound:        *outbound,
 	}, nil
 }
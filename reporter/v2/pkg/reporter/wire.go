@@ -53,6 +53,7 @@ func NewReporter(
 			"ReportName", "K8SClient", "Ctx", "Config", "K8SScheme"),
 		providePrometheusSetup,
 		prometheus.NewPrometheusAPIForReporter,
+		provideRemoteStoreAPI,
 		reconcileutils.CommandRunnerProviderSet,
 		wire.InterfaceValue(new(logr.Logger), logger),
 		getMarketplaceReport,
@@ -72,12 +72,19 @@ func NewReporter(task *Task) (*MarketplaceReporter, error) {
 	if err != nil {
 		return nil, err
 	}
-	prometheusAPISetup := providePrometheusSetup(reporterConfig, meterReport, service)
+	prometheusAPISetup, err := providePrometheusSetup(contextContext, reporterConfig, meterReport, service, simpleClient)
+	if err != nil {
+		return nil, err
+	}
 	prometheusAPI, err := prometheus.NewPrometheusAPIForReporter(prometheusAPISetup)
 	if err != nil {
 		return nil, err
 	}
-	marketplaceReporter, err := NewMarketplaceReporter(reporterConfig, simpleClient, meterReport, marketplaceConfig, service, prometheusAPI)
+	remoteStoreAPI, err := provideRemoteStoreAPI(contextContext, reporterConfig, meterReport, simpleClient)
+	if err != nil {
+		return nil, err
+	}
+	marketplaceReporter, err := NewMarketplaceReporter(reporterConfig, simpleClient, meterReport, marketplaceConfig, service, prometheusAPI, remoteStoreAPI)
 	if err != nil {
 		return nil, err
 	}
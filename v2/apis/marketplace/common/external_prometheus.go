@@ -0,0 +1,46 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ExternalPrometheus is a Prometheus compatible query endpoint, such as
+// Thanos Querier, used for metering instead of the bundled Prometheus.
+// Secrets and config maps are read from the namespace of the resource
+// that references the endpoint.
+// +kubebuilder:object:generate:=true
+type ExternalPrometheus struct {
+	// URL of the query endpoint, i.e. https://thanos-querier.openshift-monitoring.svc:9091
+	// Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// BearerTokenSecret is the secret key holding a bearer token to
+	// authenticate with.
+	// Optional
+	BearerTokenSecret *corev1.SecretKeySelector `json:"bearerTokenSecret,omitempty"`
+
+	// BasicAuth allow the endpoint to authenticate over basic authentication
+	// Optional
+	BasicAuth *monitoringv1.BasicAuth `json:"basicAuth,omitempty"`
+
+	// TLSConfig holds the CA bundle used to verify the endpoint and the
+	// client certificate and key for mTLS.
+	// Optional
+	TLSConfig *monitoringv1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+}
@@ -33,4 +33,13 @@ type Features struct {
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="hidden"
 	// +optional
 	Registration *bool `json:"registration,omitempty"`
+
+	// Gates enables or disables the named feature gates, i.e. Metering, Reporting,
+	// IBMCatalogSource or ResourceRecommendations. Deployment and Registration are
+	// set by their own fields.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.displayName="Feature gates"
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="hidden"
+	// +optional
+	Gates map[string]bool `json:"gates,omitempty"`
 }
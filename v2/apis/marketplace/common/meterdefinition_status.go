@@ -20,6 +20,7 @@ import (
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/status"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -35,6 +36,54 @@ type WorkloadResource struct {
 	NamespacedNameReference `json:",inline"`
 }
 
+// MeterDefinitionTemplateStatus records the template a MeterDefinition was rendered from
+// +kubebuilder:object:generate:=true
+type MeterDefinitionTemplateStatus struct {
+	// Name of the MeterDefinitionTemplate
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	Name string `json:"name"`
+
+	// Version of the template that was rendered
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	Version string `json:"version"`
+
+	// ObservedGeneration is the generation of the template that was rendered
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// RenderedSpec is the output of the template
+	// +optional
+	RenderedSpec string `json:"renderedSpec,omitempty"`
+}
+
+// MeterStatus is the last observed reporting state of a single meter
+// +kubebuilder:object:generate:=true
+type MeterStatus struct {
+	// MetricID is the metric id of the meter
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	MetricID string `json:"metricId"`
+
+	// LastReportTime is the end time of the last report that returned data for the meter
+	// +optional
+	LastReportTime *metav1.Time `json:"lastReportTime,omitempty"`
+
+	// LastValue is the most recent value the meter reported, summed across its series
+	// +optional
+	LastValue string `json:"lastValue,omitempty"`
+
+	// SeriesCount is the number of series returned by the last report
+	// +optional
+	SeriesCount int `json:"seriesCount,omitempty"`
+
+	// LastQueryError is the error returned by the last report's query, if any
+	// +optional
+	LastQueryError string `json:"lastQueryError,omitempty"`
+
+	// Conditions represent the health of the meter
+	// +optional
+	Conditions status.Conditions `json:"conditions,omitempty"`
+}
+
 type ByAlphabetical []WorkloadResource
 
 func (a ByAlphabetical) Len() int      { return len(a) }
@@ -75,6 +124,11 @@ const (
 	MeterDefConditionReasonSignatureUnverified         status.ConditionReason = "Signature unverified"
 	MeterDefConditionReasonSignatureVerified           status.ConditionReason = "Signature verified"
 	MeterDefConditionReasonSignatureVerificationFailed status.ConditionReason = "Signature verification failed"
+
+	MeterConditionTypeHealthy      status.ConditionType   = "Healthy"
+	MeterConditionReasonHealthy    status.ConditionReason = "Healthy"
+	MeterConditionReasonNoData     status.ConditionReason = "NoData"
+	MeterConditionReasonQueryError status.ConditionReason = "QueryError"
 )
 
 var (
@@ -112,4 +166,26 @@ var (
 		Reason:  MeterDefConditionReasonSignatureVerificationFailed,
 		Message: "Meter definition signature verification failed.",
 	}
+
+	// Meter returned data on the last report
+	MeterConditionHealthy = status.Condition{
+		Type:    MeterConditionTypeHealthy,
+		Status:  corev1.ConditionTrue,
+		Reason:  MeterConditionReasonHealthy,
+		Message: "Meter returned data on the last report.",
+	}
+	// Meter query succeeded but returned no series
+	MeterConditionNoData = status.Condition{
+		Type:    MeterConditionTypeHealthy,
+		Status:  corev1.ConditionFalse,
+		Reason:  MeterConditionReasonNoData,
+		Message: "Meter returned no data on the last report.",
+	}
+	// Meter query failed; message is replaced with the error
+	MeterConditionQueryError = status.Condition{
+		Type:    MeterConditionTypeHealthy,
+		Status:  corev1.ConditionFalse,
+		Reason:  MeterConditionReasonQueryError,
+		Message: "Meter query failed on the last report.",
+	}
 )
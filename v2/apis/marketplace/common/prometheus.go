@@ -44,6 +44,10 @@ type MeterDefPrometheusLabels struct {
 	MeterDescription   string `json:"meter_description,omitempty" mapstructure:"meter_description,omitempty" template:""`
 	ValueLabelOverride string `json:"value_label_override,omitempty" mapstructure:"value_label_override,omitempty" template:""`
 	DateLabelOverride  string `json:"date_label_override,omitempty" mapstructure:"date_label_override,omitempty" template:""`
+
+	QueryMaxSeries  *int          `json:"query_max_series,omitempty,string" mapstructure:"query_max_series,omitempty"`
+	QueryMaxSamples *int          `json:"query_max_samples,omitempty,string" mapstructure:"query_max_samples,omitempty"`
+	QueryTimeout    *MetricPeriod `json:"query_timeout,omitempty" mapstructure:"query_timeout,omitempty"`
 }
 
 func (m *MeterDefPrometheusLabels) Defaults() {
@@ -21,9 +21,41 @@ limitations under the License.
 package common
 
 import (
-	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/status"
+	"k8s.io/api/core/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalPrometheus) DeepCopyInto(out *ExternalPrometheus) {
+	*out = *in
+	if in.BearerTokenSecret != nil {
+		in, out := &in.BearerTokenSecret, &out.BearerTokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(monitoringv1.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(monitoringv1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalPrometheus.
+func (in *ExternalPrometheus) DeepCopy() *ExternalPrometheus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalPrometheus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Features) DeepCopyInto(out *Features) {
 	*out = *in
@@ -37,6 +69,13 @@ func (in *Features) DeepCopyInto(out *Features) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Gates != nil {
+		in, out := &in.Gates, &out.Gates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Features.
@@ -107,6 +146,47 @@ func (in *JobReference) DeepCopy() *JobReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeterDefinitionTemplateStatus) DeepCopyInto(out *MeterDefinitionTemplateStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterDefinitionTemplateStatus.
+func (in *MeterDefinitionTemplateStatus) DeepCopy() *MeterDefinitionTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MeterDefinitionTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeterStatus) DeepCopyInto(out *MeterStatus) {
+	*out = *in
+	if in.LastReportTime != nil {
+		in, out := &in.LastReportTime, &out.LastReportTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(status.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterStatus.
+func (in *MeterStatus) DeepCopy() *MeterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MeterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedNameReference) DeepCopyInto(out *NamespacedNameReference) {
 	*out = *in
@@ -168,13 +248,13 @@ func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
 	out.TargetPort = in.TargetPort
 	if in.TLSConfig != nil {
 		in, out := &in.TLSConfig, &out.TLSConfig
-		*out = new(v1.TLSConfig)
+		*out = new(monitoringv1.TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	in.BearerTokenSecret.DeepCopyInto(&out.BearerTokenSecret)
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
-		*out = new(v1.TLSConfig)
+		*out = new(monitoringv1.TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
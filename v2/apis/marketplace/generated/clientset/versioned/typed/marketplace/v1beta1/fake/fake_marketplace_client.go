@@ -32,6 +32,10 @@ func (c *FakeMarketplaceV1beta1) MeterDefinitions(namespace string) v1beta1.Mete
 	return &FakeMeterDefinitions{c, namespace}
 }
 
+func (c *FakeMarketplaceV1beta1) MeterDefinitionTemplates() v1beta1.MeterDefinitionTemplateInterface {
+	return &FakeMeterDefinitionTemplates{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMarketplaceV1beta1) RESTClient() rest.Interface {
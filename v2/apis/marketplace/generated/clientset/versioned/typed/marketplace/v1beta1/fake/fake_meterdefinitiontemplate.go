@@ -0,0 +1,122 @@
+/*
+Copyright 2020 IBM Co..
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeMeterDefinitionTemplates implements MeterDefinitionTemplateInterface
+type FakeMeterDefinitionTemplates struct {
+	Fake *FakeMarketplaceV1beta1
+}
+
+var meterdefinitiontemplatesResource = schema.GroupVersionResource{Group: "marketplace.redhat.com", Version: "v1beta1", Resource: "meterdefinitiontemplates"}
+
+var meterdefinitiontemplatesKind = schema.GroupVersionKind{Group: "marketplace.redhat.com", Version: "v1beta1", Kind: "MeterDefinitionTemplate"}
+
+// Get takes name of the meterDefinitionTemplate, and returns the corresponding meterDefinitionTemplate object, and an error if there is any.
+func (c *FakeMeterDefinitionTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.MeterDefinitionTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(meterdefinitiontemplatesResource, name), &v1beta1.MeterDefinitionTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.MeterDefinitionTemplate), err
+}
+
+// List takes label and field selectors, and returns the list of MeterDefinitionTemplates that match those selectors.
+func (c *FakeMeterDefinitionTemplates) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.MeterDefinitionTemplateList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(meterdefinitiontemplatesResource, meterdefinitiontemplatesKind, opts), &v1beta1.MeterDefinitionTemplateList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.MeterDefinitionTemplateList{ListMeta: obj.(*v1beta1.MeterDefinitionTemplateList).ListMeta}
+	for _, item := range obj.(*v1beta1.MeterDefinitionTemplateList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested meterDefinitionTemplates.
+func (c *FakeMeterDefinitionTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(meterdefinitiontemplatesResource, opts))
+}
+
+// Create takes the representation of a meterDefinitionTemplate and creates it.  Returns the server's representation of the meterDefinitionTemplate, and an error, if there is any.
+func (c *FakeMeterDefinitionTemplates) Create(ctx context.Context, meterDefinitionTemplate *v1beta1.MeterDefinitionTemplate, opts v1.CreateOptions) (result *v1beta1.MeterDefinitionTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(meterdefinitiontemplatesResource, meterDefinitionTemplate), &v1beta1.MeterDefinitionTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.MeterDefinitionTemplate), err
+}
+
+// Update takes the representation of a meterDefinitionTemplate and updates it. Returns the server's representation of the meterDefinitionTemplate, and an error, if there is any.
+func (c *FakeMeterDefinitionTemplates) Update(ctx context.Context, meterDefinitionTemplate *v1beta1.MeterDefinitionTemplate, opts v1.UpdateOptions) (result *v1beta1.MeterDefinitionTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(meterdefinitiontemplatesResource, meterDefinitionTemplate), &v1beta1.MeterDefinitionTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.MeterDefinitionTemplate), err
+}
+
+// Delete takes name of the meterDefinitionTemplate and deletes it. Returns an error if one occurs.
+func (c *FakeMeterDefinitionTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(meterdefinitiontemplatesResource, name), &v1beta1.MeterDefinitionTemplate{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeMeterDefinitionTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(meterdefinitiontemplatesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.MeterDefinitionTemplateList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched meterDefinitionTemplate.
+func (c *FakeMeterDefinitionTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.MeterDefinitionTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(meterdefinitiontemplatesResource, name, pt, data, subresources...), &v1beta1.MeterDefinitionTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.MeterDefinitionTemplate), err
+}
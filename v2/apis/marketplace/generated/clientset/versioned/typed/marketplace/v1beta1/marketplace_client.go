@@ -27,6 +27,7 @@ import (
 type MarketplaceV1beta1Interface interface {
 	RESTClient() rest.Interface
 	MeterDefinitionsGetter
+	MeterDefinitionTemplatesGetter
 }
 
 // MarketplaceV1beta1Client is used to interact with features provided by the marketplace.redhat.com group.
@@ -38,6 +39,10 @@ func (c *MarketplaceV1beta1Client) MeterDefinitions(namespace string) MeterDefin
 	return newMeterDefinitions(c, namespace)
 }
 
+func (c *MarketplaceV1beta1Client) MeterDefinitionTemplates() MeterDefinitionTemplateInterface {
+	return newMeterDefinitionTemplates(c)
+}
+
 // NewForConfig creates a new MarketplaceV1beta1Client for the given config.
 func NewForConfig(c *rest.Config) (*MarketplaceV1beta1Client, error) {
 	config := *c
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 IBM Co..
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	scheme "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/generated/clientset/versioned/scheme"
+	v1beta1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// MeterDefinitionTemplatesGetter has a method to return a MeterDefinitionTemplateInterface.
+// A group's client should implement this interface.
+type MeterDefinitionTemplatesGetter interface {
+	MeterDefinitionTemplates() MeterDefinitionTemplateInterface
+}
+
+// MeterDefinitionTemplateInterface has methods to work with MeterDefinitionTemplate resources.
+type MeterDefinitionTemplateInterface interface {
+	Create(ctx context.Context, meterDefinitionTemplate *v1beta1.MeterDefinitionTemplate, opts v1.CreateOptions) (*v1beta1.MeterDefinitionTemplate, error)
+	Update(ctx context.Context, meterDefinitionTemplate *v1beta1.MeterDefinitionTemplate, opts v1.UpdateOptions) (*v1beta1.MeterDefinitionTemplate, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.MeterDefinitionTemplate, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.MeterDefinitionTemplateList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.MeterDefinitionTemplate, err error)
+	MeterDefinitionTemplateExpansion
+}
+
+// meterDefinitionTemplates implements MeterDefinitionTemplateInterface
+type meterDefinitionTemplates struct {
+	client rest.Interface
+}
+
+// newMeterDefinitionTemplates returns a MeterDefinitionTemplates
+func newMeterDefinitionTemplates(c *MarketplaceV1beta1Client) *meterDefinitionTemplates {
+	return &meterDefinitionTemplates{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the meterDefinitionTemplate, and returns the corresponding meterDefinitionTemplate object, and an error if there is any.
+func (c *meterDefinitionTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.MeterDefinitionTemplate, err error) {
+	result = &v1beta1.MeterDefinitionTemplate{}
+	err = c.client.Get().
+		Resource("meterdefinitiontemplates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of MeterDefinitionTemplates that match those selectors.
+func (c *meterDefinitionTemplates) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.MeterDefinitionTemplateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.MeterDefinitionTemplateList{}
+	err = c.client.Get().
+		Resource("meterdefinitiontemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested meterDefinitionTemplates.
+func (c *meterDefinitionTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("meterdefinitiontemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a meterDefinitionTemplate and creates it.  Returns the server's representation of the meterDefinitionTemplate, and an error, if there is any.
+func (c *meterDefinitionTemplates) Create(ctx context.Context, meterDefinitionTemplate *v1beta1.MeterDefinitionTemplate, opts v1.CreateOptions) (result *v1beta1.MeterDefinitionTemplate, err error) {
+	result = &v1beta1.MeterDefinitionTemplate{}
+	err = c.client.Post().
+		Resource("meterdefinitiontemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(meterDefinitionTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a meterDefinitionTemplate and updates it. Returns the server's representation of the meterDefinitionTemplate, and an error, if there is any.
+func (c *meterDefinitionTemplates) Update(ctx context.Context, meterDefinitionTemplate *v1beta1.MeterDefinitionTemplate, opts v1.UpdateOptions) (result *v1beta1.MeterDefinitionTemplate, err error) {
+	result = &v1beta1.MeterDefinitionTemplate{}
+	err = c.client.Put().
+		Resource("meterdefinitiontemplates").
+		Name(meterDefinitionTemplate.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(meterDefinitionTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the meterDefinitionTemplate and deletes it. Returns an error if one occurs.
+func (c *meterDefinitionTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("meterdefinitiontemplates").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *meterDefinitionTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("meterdefinitiontemplates").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched meterDefinitionTemplate.
+func (c *meterDefinitionTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.MeterDefinitionTemplate, err error) {
+	result = &v1beta1.MeterDefinitionTemplate{}
+	err = c.client.Patch(pt).
+		Resource("meterdefinitiontemplates").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
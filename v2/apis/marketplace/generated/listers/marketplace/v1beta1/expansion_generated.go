@@ -25,3 +25,7 @@ type MeterDefinitionListerExpansion interface{}
 // MeterDefinitionNamespaceListerExpansion allows custom methods to be added to
 // MeterDefinitionNamespaceLister.
 type MeterDefinitionNamespaceListerExpansion interface{}
+
+// MeterDefinitionTemplateListerExpansion allows custom methods to be added to
+// MeterDefinitionTemplateLister.
+type MeterDefinitionTemplateListerExpansion interface{}
@@ -0,0 +1,68 @@
+/*
+Copyright 2020 IBM Co..
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MeterDefinitionTemplateLister helps list MeterDefinitionTemplates.
+// All objects returned here must be treated as read-only.
+type MeterDefinitionTemplateLister interface {
+	// List lists all MeterDefinitionTemplates in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.MeterDefinitionTemplate, err error)
+	// Get retrieves the MeterDefinitionTemplate from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.MeterDefinitionTemplate, error)
+	MeterDefinitionTemplateListerExpansion
+}
+
+// meterDefinitionTemplateLister implements the MeterDefinitionTemplateLister interface.
+type meterDefinitionTemplateLister struct {
+	indexer cache.Indexer
+}
+
+// NewMeterDefinitionTemplateLister returns a new MeterDefinitionTemplateLister.
+func NewMeterDefinitionTemplateLister(indexer cache.Indexer) MeterDefinitionTemplateLister {
+	return &meterDefinitionTemplateLister{indexer: indexer}
+}
+
+// List lists all MeterDefinitionTemplates in the indexer.
+func (s *meterDefinitionTemplateLister) List(selector labels.Selector) (ret []*v1beta1.MeterDefinitionTemplate, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.MeterDefinitionTemplate))
+	})
+	return ret, err
+}
+
+// Get retrieves the MeterDefinitionTemplate from the index for a given name.
+func (s *meterDefinitionTemplateLister) Get(name string) (*v1beta1.MeterDefinitionTemplate, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("meterdefinitiontemplate"), name)
+	}
+	return obj.(*v1beta1.MeterDefinitionTemplate), nil
+}
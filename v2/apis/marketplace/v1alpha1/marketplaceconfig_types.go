@@ -68,6 +68,156 @@ type MarketplaceConfigSpec struct {
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.displayName="Namespace LabelSelector"
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="hidden"
 	NamespaceLabelSelector *metav1.LabelSelector `json:"namespaceLabelSelector,omitempty"`
+
+	// Decommission uploads the usage metered so far, unregisters the cluster
+	// and removes the marketplace components. Deleting the MarketplaceConfig
+	// decommissions the cluster as well.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.displayName="Decommission"
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	// +optional
+	Decommission bool `json:"decommission,omitempty"`
+
+	// WatchResourcePolicy selects the resources labelled razee/watch-resource
+	// and reported in the cluster inventory. Nodes are reported when not set.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.displayName="Watch Resource Policy"
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="hidden"
+	// +optional
+	WatchResourcePolicy *WatchResourcePolicy `json:"watchResourcePolicy,omitempty"`
+
+	// CatalogSources are the catalog sources installed in the
+	// openshift-marketplace namespace. The IBM and Opencloud catalog sources
+	// are installed per InstallIBMCatalogSource when not set. Catalog sources
+	// installed by the operator that are no longer listed are removed. The
+	// operator may only update and delete the IBM and Opencloud catalog
+	// sources, other catalog sources are updated and removed once a role
+	// granting update, patch and delete on them in openshift-marketplace is
+	// bound to the operator service account.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.displayName="Catalog Sources"
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="hidden"
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	CatalogSources []CatalogSourceConfig `json:"catalogSources,omitempty"`
+}
+
+// CatalogSourceConfig is a catalog source installed by the operator.
+type CatalogSourceConfig struct {
+	// Name of the catalog source. The ibm-operator-catalog and
+	// opencloud-operators catalog sources default to the IBM and Opencloud
+	// catalogs.
+	Name string `json:"name"`
+
+	// DisplayName of the catalog source.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Publisher of the catalog source.
+	// +optional
+	Publisher string `json:"publisher,omitempty"`
+
+	// Image of the catalog, required unless the catalog source has a default.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// MirrorPolicy is how the image is mapped to a mirror,
+	// ImageContentSourcePolicy by default.
+	// +optional
+	MirrorPolicy MirrorPolicy `json:"mirrorPolicy,omitempty"`
+
+	// PollInterval is how often the image is polled for updates, 45m by
+	// default. A zero interval disables polling.
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+
+	// Priority of the catalog source when resolving dependencies, higher is
+	// preferred.
+	// +optional
+	Priority int `json:"priority,omitempty"`
+}
+
+// MirrorPolicy is how the image of a catalog source is mapped to a mirror.
+// +kubebuilder:validation:Enum=ImageContentSourcePolicy;None
+type MirrorPolicy string
+
+const (
+	// MirrorPolicyImageContentSourcePolicy pulls an image referenced by
+	// digest from the first mirror of its repository in the
+	// ImageContentSourcePolicies of the cluster, as the policies do for
+	// pods. Images referenced by tag are pulled as is.
+	MirrorPolicyImageContentSourcePolicy MirrorPolicy = "ImageContentSourcePolicy"
+	// MirrorPolicyNone pulls the image as is.
+	MirrorPolicyNone MirrorPolicy = "None"
+)
+
+// WatchResourcePolicy is the resources labelled razee/watch-resource. The
+// operator needs list, watch and patch on the kinds of the rules, its cluster
+// role only grants them on a few core kinds such as nodes. Kinds it is not
+// allowed to label are reported by the WatchResourcesLabelled condition until
+// a role granting them is bound to the operator service account.
+//
+// The operator watches and caches every resource of the kinds of the rules
+// cluster wide, so kinds with many or large resources such as Secrets raise
+// its memory use and the load on the API server.
+//
+// Resources labelled by the policy are marked with
+// marketplace.redhat.com/watch-resource-policy. When the rules change, their
+// level is lowered or the labels are removed from the resources no longer
+// selected, also for the kinds removed from the rules.
+type WatchResourcePolicy struct {
+	// Rules select the resources to label. A resource selected by rules of
+	// different levels is labelled detail.
+	// +listType=atomic
+	Rules []WatchResourceRule `json:"rules"`
+}
+
+// WatchLevel is how much of a resource is reported.
+// +kubebuilder:validation:Enum=lite;detail
+type WatchLevel string
+
+const (
+	// WatchLevelLite reports the metadata and status of the resource.
+	WatchLevelLite WatchLevel = "lite"
+	// WatchLevelDetail reports the whole resource.
+	WatchLevelDetail WatchLevel = "detail"
+)
+
+// WatchResourceRule labels the resources of a kind matching the selector.
+type WatchResourceRule struct {
+	// APIVersion of the resources, i.e. v1 or operators.coreos.com/v1alpha1.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the resources, i.e. Node.
+	Kind string `json:"kind"`
+
+	// Selector selects the resources labelled, every resource of the kind
+	// when not set.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Level is how much of the resources is reported, lite by default.
+	// +optional
+	Level WatchLevel `json:"level,omitempty"`
+}
+
+// WatchResourceRules returns the rules of the watch resource policy, nodes
+// are labelled lite when there is no policy.
+func (m *MarketplaceConfig) WatchResourceRules() []WatchResourceRule {
+	if m.Spec.WatchResourcePolicy == nil {
+		return []WatchResourceRule{{APIVersion: "v1", Kind: "Node", Level: WatchLevelLite}}
+	}
+
+	rules := make([]WatchResourceRule, 0, len(m.Spec.WatchResourcePolicy.Rules))
+	for _, rule := range m.Spec.WatchResourcePolicy.Rules {
+		if rule.Level == "" {
+			rule.Level = WatchLevelLite
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules
 }
 
 // MarketplaceConfigStatus defines the observed state of MarketplaceConfig
@@ -88,6 +238,108 @@ type MarketplaceConfigStatus struct {
 	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
 	// +optional
 	MeterBaseSubConditions status.Conditions `json:"meterBaseSubConditions,omitempty"`
+
+	// Registration is the progress of the cluster registration with the
+	// pull secret.
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	Registration *ClusterRegistrationStatus `json:"registration,omitempty"`
+
+	// Decommission is the progress of decommissioning the cluster.
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	Decommission *DecommissionStatus `json:"decommission,omitempty"`
+
+	// FeatureGates are the effective feature gates of the operator.
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	FeatureGates []FeatureGateStatus `json:"featureGates,omitempty"`
+
+	// WatchResourceKinds are the kinds labelled by the watch resource
+	// policy, their labels are removed when the kinds are removed from the
+	// rules.
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	WatchResourceKinds []WatchResourceKind `json:"watchResourceKinds,omitempty"`
+}
+
+// WatchResourceKind is a kind labelled by the watch resource policy.
+type WatchResourceKind struct {
+	// APIVersion of the resources.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the resources.
+	Kind string `json:"kind"`
+}
+
+// FeatureGateStatus is the effective state of a feature gate.
+type FeatureGateStatus struct {
+	// Name of the feature gate.
+	Name string `json:"name"`
+
+	// Maturity of the feature, Alpha, Beta or GA.
+	Maturity string `json:"maturity"`
+
+	// Enabled is true if the feature is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// ClusterRegistrationStatus is the observed state of the cluster
+// registration.
+type ClusterRegistrationStatus struct {
+	// Conditions are TokenValid, TokenExpiring, AccountResolved,
+	// OperatorSecretFetched and Registered.
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:io.kubernetes.conditions"
+	// +optional
+	Conditions status.Conditions `json:"conditions,omitempty"`
+
+	// TokenExpiry is when the token of the pull secret expires.
+	// +optional
+	TokenExpiry *metav1.Time `json:"tokenExpiry,omitempty"`
+
+	// LastContactTime is the last time the marketplace api responded.
+	// +optional
+	LastContactTime *metav1.Time `json:"lastContactTime,omitempty"`
+}
+
+// DecommissionPhase is a step of decommissioning the cluster.
+type DecommissionPhase string
+
+const (
+	// DecommissionPhaseFinalReport reports the usage metered since the last report.
+	DecommissionPhaseFinalReport DecommissionPhase = "FinalReport"
+	// DecommissionPhaseUnregister unregisters the cluster from the marketplace.
+	DecommissionPhaseUnregister DecommissionPhase = "Unregister"
+	// DecommissionPhaseRemoveRazee removes the RazeeDeployment.
+	DecommissionPhaseRemoveRazee DecommissionPhase = "RemoveRazee"
+	// DecommissionPhaseRemoveMeterBase removes the MeterBase.
+	DecommissionPhaseRemoveMeterBase DecommissionPhase = "RemoveMeterBase"
+	// DecommissionPhaseComplete means the cluster is decommissioned.
+	DecommissionPhaseComplete DecommissionPhase = "Complete"
+)
+
+// DecommissionStatus is the observed state of decommissioning the cluster.
+type DecommissionStatus struct {
+	// Phase is the step the decommission is at.
+	// +kubebuilder:validation:Enum=FinalReport;Unregister;RemoveRazee;RemoveMeterBase;Complete
+	Phase DecommissionPhase `json:"phase"`
+
+	// Message describes the progress of the phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// FinalReport is the name of the MeterReport of the usage metered
+	// since the last report.
+	// +optional
+	FinalReport string `json:"finalReport,omitempty"`
+
+	// StartTime is when the decommission started, the final report ends at it.
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is when the decommission completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 }
 
 // MarketplaceConfig is configuration manager for our Red Hat Marketplace controllers
@@ -100,6 +352,7 @@ type MarketplaceConfigStatus struct {
 // +kubebuilder:printcolumn:name="STEP",type=string,JSONPath=`.status.conditions[?(@.type == "Installing")].reason`
 // +kubebuilder:printcolumn:name="REGISTERED",type=string,JSONPath=`.status.conditions[?(@.type == "Registered")].status`
 // +kubebuilder:printcolumn:name="REGISTERED_MSG",type=string,JSONPath=`.status.conditions[?(@.type == "Registered")].message`
+// +kubebuilder:printcolumn:name="DECOMMISSION",type=string,JSONPath=`.status.decommission.phase`
 // +operator-sdk:gen-csv:customresourcedefinitions.displayName="Marketplace"
 // +operator-sdk:gen-csv:customresourcedefinitions.resources=`RazeeDeployment,v1alpha1,"redhat-marketplace-operator"`
 // +operator-sdk:gen-csv:customresourcedefinitions.resources=`OperatorSource,v1,"redhat-marketplace-operator"`
@@ -142,6 +395,37 @@ const (
 	ReasonOperatingNormally     status.ConditionReason = "OperatingNormally"
 	ReasonNoError               status.ConditionReason = ReasonOperatingNormally
 
+	// Conditions of the cluster registration
+	// ConditionTokenValid means the pull secret has a token that is not expired.
+	ConditionTokenValid status.ConditionType = "TokenValid"
+	// ConditionAccountResolved means the token names the marketplace account.
+	ConditionAccountResolved status.ConditionType = "AccountResolved"
+	// ConditionOperatorSecretFetched means the rhm-operator-secret was fetched from the marketplace.
+	ConditionOperatorSecretFetched status.ConditionType = "OperatorSecretFetched"
+	// ConditionTokenExpiring means the pull secret token expires within a warning threshold.
+	ConditionTokenExpiring status.ConditionType = "TokenExpiring"
+
+	// Reasons for the cluster registration
+	ReasonTokenParsed       status.ConditionReason = "TokenParsed"
+	ReasonTokenMissing      status.ConditionReason = "TokenMissing"
+	ReasonTokenInvalid      status.ConditionReason = "TokenInvalid"
+	ReasonTokenExpired      status.ConditionReason = "TokenExpired"
+	ReasonTokenExpiringSoon status.ConditionReason = "TokenExpiringSoon"
+	ReasonTokenNotExpiring  status.ConditionReason = "TokenNotExpiring"
+	ReasonAccountFound      status.ConditionReason = "AccountFound"
+	ReasonAccountMissing    status.ConditionReason = "AccountMissing"
+	ReasonSecretFetched     status.ConditionReason = "SecretFetched"
+	ReasonSecretFetchFailed status.ConditionReason = "SecretFetchFailed"
+	ReasonAwaitingResponse  status.ConditionReason = "AwaitingRegistrationResponse"
+
+	// ConditionWatchResourcesLabelled means the resources selected by the watch
+	// resource policy are labelled.
+	ConditionWatchResourcesLabelled status.ConditionType = "WatchResourcesLabelled"
+
+	// Reasons for labelling the watched resources
+	ReasonWatchResourcesLabelled  status.ConditionReason = "ResourcesLabelled"
+	ReasonWatchResourcesForbidden status.ConditionReason = "LabellingForbidden"
+
 	// Enablement/Disablement of features conditions
 	// ConditionDeploymentEnabled means the particular option is enabled
 	ConditionDeploymentEnabled status.ConditionType = "DeploymentEnabled"
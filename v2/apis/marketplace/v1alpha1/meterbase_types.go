@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
 	status "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/status"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -44,6 +45,12 @@ type StorageSpec struct {
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:com.tectonic.ui:hidden"
 	// +optional
 	EmptyDir *corev1.EmptyDirVolumeSource `json:"emptyDir,omitempty"`
+
+	// AutoExpand raises the storage size when it is too small for the
+	// estimated usage. The storage class must allow volume expansion.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	AutoExpand bool `json:"autoExpand,omitempty"`
 }
 
 // PrometheusSpec contains configuration regarding prometheus
@@ -66,12 +73,63 @@ type PrometheusSpec struct {
 	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
 	Storage StorageSpec `json:"storage"`
 
-	// Replicas defines the number of desired replicas for the prometheus deployment. Used primarily when running metering on CRC
+	// Replicas defines the number of desired replicas for the prometheus deployment. Used primarily when running metering on CRC.
+	// Two or more replicas run Prometheus in high availability: replicas are spread across nodes,
+	// guarded by a pod disruption budget and the reporter deduplicates their results.
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:com.tectonic.ui:hidden"
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Retention is how long Prometheus keeps data, i.e. 30d. It should cover
+	// the days MeterReports may be re-run for. Default is 30d.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(ms|s|m|h|d|w|y)$`
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	Retention string `json:"retention,omitempty"`
+
+	// RetentionSize is the maximum size of the Prometheus data, i.e. 38GB.
+	// Default is the storage size less 2Gi.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(B|KB|MB|GB|TB|PB|EB)$`
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	RetentionSize string `json:"retentionSize,omitempty"`
+
+	// RemoteWrite sends the metering series to a long-term store so reports
+	// can be completed if the Prometheus storage is lost.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	RemoteWrite *PrometheusRemoteWriteSpec `json:"remoteWrite,omitempty"`
+}
+
+// PrometheusRemoteWriteSpec configures the remote write of the metering
+// series. Only the meterdef_* series and the recorded meter series are sent.
+// Secrets and config maps are read from the namespace of the MeterBase.
+type PrometheusRemoteWriteSpec struct {
+	// URL of the remote write endpoint.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// BearerTokenSecret is the secret key holding a bearer token to
+	// authenticate with.
+	// +optional
+	BearerTokenSecret *corev1.SecretKeySelector `json:"bearerTokenSecret,omitempty"`
+
+	// BasicAuth allow the endpoint to authenticate over basic authentication.
+	// +optional
+	BasicAuth *monitoringv1.BasicAuth `json:"basicAuth,omitempty"`
+
+	// TLSConfig holds the CA bundle used to verify the endpoint and the
+	// client certificate and key for mTLS.
+	// +optional
+	TLSConfig *monitoringv1.SafeTLSConfig `json:"tlsConfig,omitempty"`
+
+	// Query is the Prometheus compatible query endpoint of the long-term
+	// store. The reporter reads from it when the local Prometheus is missing
+	// data for a report interval.
+	// +optional
+	Query *common.ExternalPrometheus `json:"query,omitempty"`
 }
 
 // MeterBaseSpec defines the desired state of MeterBase
@@ -90,12 +148,84 @@ type MeterBaseSpec struct {
 	// +optional
 	Prometheus *PrometheusSpec `json:"prometheus,omitempty"`
 
+	// ExternalPrometheus is a Prometheus compatible query endpoint, like Thanos Querier,
+	// to meter from. When set the bundled Prometheus is not installed and only the
+	// metric-state service monitor is created for the external stack to scrape. The
+	// external Prometheus must select the rhm-metric-state service monitor and the
+	// PrometheusRules labeled marketplace.redhat.com/metering=true in the namespace
+	// of the MeterBase.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	ExternalPrometheus *common.ExternalPrometheus `json:"externalPrometheus,omitempty"`
+
+	// ExternalPrometheusRef is the Prometheus resource of the external stack. When set,
+	// the operator checks its selectors pick up the resources created for it and reports
+	// the result in the ExternalPrometheusSelected condition.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	ExternalPrometheusRef *common.NamespacedNameReference `json:"externalPrometheusRef,omitempty"`
+
 	// AdditionalConfigs are set by meter definitions and meterbase to what is available on the
 	// system.
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
 	// +optional
 	AdditionalScrapeConfigs *corev1.SecretKeySelector `json:"additionalScrapeConfigs,omitempty"`
+
+	// AutoApplyRecommendations sets the resources of Prometheus, metric-state
+	// and the reporter to the recommendations in the status. Resources set in
+	// prometheus.resources take precedence.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	AutoApplyRecommendations bool `json:"autoApplyRecommendations,omitempty"`
+}
+
+// Metering components resources are recommended for.
+const (
+	RecommendationComponentPrometheus  = "prometheus"
+	RecommendationComponentMetricState = "metric-state"
+	RecommendationComponentReporter    = "reporter"
+)
+
+// ResourceRecommendations are the resources recommended for the containers
+// of the metering components from their usage observed by Prometheus.
+type ResourceRecommendations struct {
+	// LastUpdateTime is when the usage was last read.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+
+	// Containers are the recommendations of the containers with observed
+	// usage.
+	// +optional
+	Containers []ContainerResourceRecommendation `json:"containers,omitempty"`
+}
+
+// ContainerResourceRecommendation is the resources recommended for a
+// container of a metering component.
+type ContainerResourceRecommendation struct {
+	// Component is prometheus, metric-state or reporter.
+	Component string `json:"component"`
+
+	// Container is the name of the container.
+	Container string `json:"container"`
+
+	// Resources are the recommended requests and limits.
+	Resources corev1.ResourceRequirements `json:"resources"`
+}
+
+// Recommendation returns the resources recommended for the container of the
+// component.
+func (r *ResourceRecommendations) Recommendation(component, container string) (corev1.ResourceRequirements, bool) {
+	if r == nil {
+		return corev1.ResourceRequirements{}, false
+	}
+
+	for _, rec := range r.Containers {
+		if rec.Component == component && rec.Container == container {
+			return rec.Resources, true
+		}
+	}
+
+	return corev1.ResourceRequirements{}, false
 }
 
 // MeterBaseStatus defines the observed state of MeterBase.
@@ -126,6 +256,18 @@ type MeterBaseStatus struct {
 	// Total number of unavailable pods targeted by this Prometheus deployment.
 	// +optional
 	UnavailableReplicas *int32 `json:"unavailableReplicas,omitempty"`
+
+	// EstimatedStorage is the storage Prometheus needs to keep the current
+	// series for the retention.
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	EstimatedStorage *resource.Quantity `json:"estimatedStorage,omitempty"`
+
+	// Recommendations are the resources recommended for the metering
+	// components from their observed usage.
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	Recommendations *ResourceRecommendations `json:"recommendations,omitempty"`
 }
 
 // MeterBase is the resource that sets up Metering for Red Hat Marketplace.
@@ -164,7 +306,53 @@ func init() {
 	SchemeBuilder.Register(&MeterBase{}, &MeterBaseList{})
 }
 
+// IsExternalPrometheus returns true if metering queries an external Prometheus
+// instead of the bundled one.
+func (m *MeterBase) IsExternalPrometheus() bool {
+	return m.Spec.ExternalPrometheus != nil
+}
+
+// IsPrometheusHA returns true if the bundled Prometheus runs more than one
+// replica.
+func (m *MeterBase) IsPrometheusHA() bool {
+	return m.Spec.Prometheus != nil &&
+		m.Spec.Prometheus.Replicas != nil &&
+		*m.Spec.Prometheus.Replicas > 1
+}
+
+const (
+	// ConditionExternalPrometheusSelected means the external Prometheus selects
+	// the resources created for it by the operator.
+	ConditionExternalPrometheusSelected status.ConditionType = "ExternalPrometheusSelected"
+
+	// Reasons for the external Prometheus selection
+	ReasonExternalPrometheusSelected    status.ConditionReason = "Selected"
+	ReasonExternalPrometheusNotSelected status.ConditionReason = "NotSelected"
+	ReasonExternalPrometheusUnverified  status.ConditionReason = "Unverified"
+)
+
+const (
+	// ConditionMetricNamesUndetermined means the metric names some meters query
+	// can't be determined, so kube-state and the kubelets are scraped for every
+	// metric instead of only the metered ones.
+	ConditionMetricNamesUndetermined status.ConditionType = "MetricNamesUndetermined"
+
+	// Reasons for the metric names
+	ReasonMetricNamesDetermined   status.ConditionReason = "MetricNamesDetermined"
+	ReasonMetricNamesUndetermined status.ConditionReason = "MetricNamesUndetermined"
+)
+
 const (
+	// ConditionStorageUnderSized means Prometheus can not keep the data for
+	// the days MeterReports may be re-run for.
+	ConditionStorageUnderSized status.ConditionType = "StorageUnderSized"
+
+	// Reasons for storage sizing
+	ReasonStorageSufficient     status.ConditionReason = "StorageSufficient"
+	ReasonStorageBelowEstimate  status.ConditionReason = "StorageBelowEstimate"
+	ReasonRetentionBelowBacklog status.ConditionReason = "RetentionBelowBacklog"
+	ReasonStorageExpanding      status.ConditionReason = "StorageExpanding"
+
 	// Reasons for install
 	ReasonMeterBaseStartInstall             status.ConditionReason = "StartMeterBaseInstall"
 	ReasonMeterBasePrometheusInstall        status.ConditionReason = "StartMeterBasePrometheusInstall"
@@ -15,18 +15,131 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"time"
 
 	"emperror.dev/errors"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/conversion"
 )
 
+const (
+	// MeterDefinitionAlphaSpecAnnotation stores the v1alpha1 spec on a converted
+	// v1beta1 MeterDefinition so fields without a v1beta1 counterpart survive the
+	// conversion back.
+	MeterDefinitionAlphaSpecAnnotation = "marketplace.redhat.com/v1alpha1-spec"
+
+	// MeterDefinitionBetaSpecAnnotation stores the v1beta1 spec on a converted
+	// v1alpha1 MeterDefinition so fields without a v1alpha1 counterpart survive the
+	// conversion back.
+	MeterDefinitionBetaSpecAnnotation = "marketplace.redhat.com/v1beta1-spec"
+)
+
+var ErrNoResourceFilters = errors.Sentinel("no resource filters available")
+
 // ConvertTo converts this MeterDefinition to the Hub version (v1beta1).
 func (src *MeterDefinition) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*v1beta1.MeterDefinition)
 
+	spec, err := convertSpecToBeta(&src.Spec)
+	if err != nil {
+		return err
+	}
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+
+	stashed := &v1beta1.MeterDefinitionSpec{}
+	found, err := popSpecAnnotation(&dst.ObjectMeta, MeterDefinitionBetaSpecAnnotation, stashed)
+	if err != nil {
+		return err
+	}
+
+	restored := false
+
+	if found {
+		// The stashed spec is only trusted as a whole if the v1alpha1 object
+		// has not been changed since it was converted from it.
+		if alphaSpec, err := convertSpecToAlpha(stashed); err == nil &&
+			equality.Semantic.DeepEqual(alphaSpec, &src.Spec) {
+			spec = stashed
+			restored = true
+		} else {
+			restoreBetaOnlyFields(spec, stashed)
+		}
+	}
+
+	if !restored {
+		err = setSpecAnnotation(&dst.ObjectMeta, MeterDefinitionAlphaSpecAnnotation, &src.Spec)
+		if err != nil {
+			return err
+		}
+	}
+
+	dst.Spec = *spec
+	dst.Status.Conditions = src.Status.Conditions
+	dst.Status.WorkloadResources = src.Status.WorkloadResources
+	dst.Status.Results = src.Status.Results
+	dst.Status.Template = src.Status.Template
+	dst.Status.Meters = src.Status.Meters
+	return nil
+}
+
+var _ conversion.Convertible = &MeterDefinition{}
+
+// ConvertFrom converts from the Hub version (v1beta1) to this version.
+func (dst *MeterDefinition) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.MeterDefinition)
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+
+	stashed := &MeterDefinitionSpec{}
+	found, err := popSpecAnnotation(&dst.ObjectMeta, MeterDefinitionAlphaSpecAnnotation, stashed)
+	if err != nil {
+		return err
+	}
+
+	var spec *MeterDefinitionSpec
+
+	// The stashed spec is only trusted as a whole if the v1beta1 object
+	// has not been changed since it was converted from it.
+	if found {
+		if betaSpec, err := convertSpecToBeta(stashed); err == nil &&
+			equality.Semantic.DeepEqual(betaSpec, &src.Spec) {
+			spec = stashed
+		}
+	}
+
+	if spec == nil {
+		spec, err = convertSpecToAlpha(&src.Spec)
+		if err != nil {
+			return errors.WrapWithDetails(err, "failed to convert to v1alpha1",
+				"name", src.Name,
+				"namespace", src.Namespace)
+		}
+
+		if found {
+			restoreAlphaOnlyFields(spec, stashed)
+		}
+
+		err = setSpecAnnotation(&dst.ObjectMeta, MeterDefinitionBetaSpecAnnotation, &src.Spec)
+		if err != nil {
+			return err
+		}
+	}
+
+	dst.Spec = *spec
+	dst.Status.Conditions = src.Status.Conditions
+	dst.Status.WorkloadResources = src.Status.WorkloadResources
+	dst.Status.Results = src.Status.Results
+	dst.Status.Template = src.Status.Template
+	dst.Status.Meters = src.Status.Meters
+
+	return nil
+}
+
+func convertSpecToBeta(src *MeterDefinitionSpec) (*v1beta1.MeterDefinitionSpec, error) {
 	meters := []v1beta1.MeterWorkload{}
 
 	hrDuration, _ := time.ParseDuration("1h")
@@ -34,7 +147,7 @@ func (src *MeterDefinition) ConvertTo(dstRaw conversion.Hub) error {
 
 	var namespaceFilters *v1beta1.NamespaceFilter
 
-	switch src.Spec.WorkloadVertexType {
+	switch src.WorkloadVertexType {
 	case WorkloadVertexOperatorGroup:
 		namespaceFilters = &v1beta1.NamespaceFilter{
 			UseOperatorGroup: true,
@@ -42,13 +155,13 @@ func (src *MeterDefinition) ConvertTo(dstRaw conversion.Hub) error {
 	case WorkloadVertexNamespace:
 		namespaceFilters = &v1beta1.NamespaceFilter{
 			UseOperatorGroup: false,
-			LabelSelector:    src.Spec.VertexLabelSelector,
+			LabelSelector:    src.VertexLabelSelector,
 		}
 	}
 
 	resourceFilters := []v1beta1.ResourceFilter{}
 
-	for _, workload := range src.Spec.Workloads {
+	for _, workload := range src.Workloads {
 		filters := v1beta1.ResourceFilter{}
 
 		if workload.AnnotationSelector != nil {
@@ -68,7 +181,7 @@ func (src *MeterDefinition) ConvertTo(dstRaw conversion.Hub) error {
 		workloadType, err := ConvertWorkloadTypeBeta(workload.WorkloadType)
 
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		filters.WorkloadType = workloadType
@@ -94,46 +207,40 @@ func (src *MeterDefinition) ConvertTo(dstRaw conversion.Hub) error {
 		}
 	}
 
-	dst.ObjectMeta = src.ObjectMeta
-	dst.Spec.Group = string(src.Spec.Group)
-	dst.Spec.Kind = string(src.Spec.Kind)
-	dst.Spec.InstalledBy = src.Spec.InstalledBy
-	dst.Spec.Meters = meters
-	dst.Spec.ResourceFilters = resourceFilters
-	dst.Status.Conditions = src.Status.Conditions
-	dst.Status.WorkloadResources = src.Status.WorkloadResources
-	dst.Status.Results = src.Status.Results
-	return nil
+	return &v1beta1.MeterDefinitionSpec{
+		Group:           string(src.Group),
+		Kind:            string(src.Kind),
+		InstalledBy:     src.InstalledBy,
+		Meters:          meters,
+		ResourceFilters: resourceFilters,
+	}, nil
 }
 
-var _ conversion.Convertible = &MeterDefinition{}
-
-// ConvertFrom converts from the Hub version (v1beta1) to this version.
-func (dst *MeterDefinition) ConvertFrom(srcRaw conversion.Hub) error {
-	src := srcRaw.(*v1beta1.MeterDefinition)
+func convertSpecToAlpha(src *v1beta1.MeterDefinitionSpec) (*MeterDefinitionSpec, error) {
+	dst := &MeterDefinitionSpec{}
 	workloads := []Workload{}
 
-	if len(src.Spec.ResourceFilters) == 0 {
-		return errors.NewWithDetails("failed to convert to v1alpha1",
-			"name", src.Name,
-			"namespace", src.Namespace,
-			"details", "no resource filters available")
+	// a templated meterdefinition has no filters until it is rendered
+	if len(src.ResourceFilters) == 0 && src.TemplateRef == nil {
+		return nil, ErrNoResourceFilters
 	}
 
-	filter := src.Spec.ResourceFilters[0]
+	if len(src.ResourceFilters) != 0 {
+		filter := src.ResourceFilters[0]
 
-	if filter.Namespace != nil && filter.Namespace.UseOperatorGroup == true {
-		dst.Spec.WorkloadVertexType = WorkloadVertexOperatorGroup
-	}
+		if filter.Namespace != nil && filter.Namespace.UseOperatorGroup == true {
+			dst.WorkloadVertexType = WorkloadVertexOperatorGroup
+		}
 
-	if filter.Namespace != nil && filter.Namespace.UseOperatorGroup == false {
-		dst.Spec.WorkloadVertexType = WorkloadVertexNamespace
-		dst.Spec.VertexLabelSelector = filter.Namespace.LabelSelector
+		if filter.Namespace != nil && filter.Namespace.UseOperatorGroup == false {
+			dst.WorkloadVertexType = WorkloadVertexNamespace
+			dst.VertexLabelSelector = filter.Namespace.LabelSelector
+		}
 	}
 
 	resourceMap := map[WorkloadType]v1beta1.ResourceFilter{}
 
-	for _, filter := range src.Spec.ResourceFilters {
+	for _, filter := range src.ResourceFilters {
 		workloadType, _ := ConvertWorkloadTypeAlpha(filter.WorkloadType)
 
 		if _, ok := resourceMap[workloadType]; !ok {
@@ -141,7 +248,7 @@ func (dst *MeterDefinition) ConvertFrom(srcRaw conversion.Hub) error {
 		}
 	}
 
-	for _, meter := range src.Spec.Meters {
+	for _, meter := range src.Meters {
 		workloadType, _ := ConvertWorkloadTypeAlpha(meter.WorkloadType)
 
 		workload := Workload{
@@ -173,18 +280,86 @@ func (dst *MeterDefinition) ConvertFrom(srcRaw conversion.Hub) error {
 		workloads = append(workloads, workload)
 	}
 
-	dst.ObjectMeta = src.ObjectMeta
-	dst.Spec.Group = src.Spec.Group
-	dst.Spec.Kind = src.Spec.Kind
-	dst.Spec.Workloads = workloads
-	dst.Spec.InstalledBy = src.Spec.InstalledBy
-	dst.Status.Conditions = src.Status.Conditions
-	dst.Status.WorkloadResources = src.Status.WorkloadResources
-	dst.Status.Results = src.Status.Results
+	dst.Group = src.Group
+	dst.Kind = src.Kind
+	dst.Workloads = workloads
+	dst.InstalledBy = src.InstalledBy
+
+	return dst, nil
+}
+
+// restoreBetaOnlyFields copies the fields v1alpha1 cannot express from
+// the stashed spec, meter fields only onto meters that still exist after an edit.
+func restoreBetaOnlyFields(dst, stashed *v1beta1.MeterDefinitionSpec) {
+	dst.TemplateRef = stashed.TemplateRef
+	dst.ScrapeTargets = stashed.ScrapeTargets
+	dst.QueryLimits = stashed.QueryLimits
 
+	for i := range dst.Meters {
+		meter := &dst.Meters[i]
+
+		for _, old := range stashed.Meters {
+			if old.Metric != meter.Metric || old.WorkloadType != meter.WorkloadType {
+				continue
+			}
+
+			meter.Description = old.Description
+			meter.GroupBy = old.GroupBy
+			meter.Without = old.Without
+			meter.Period = old.Period
+			meter.DateLabelOverride = old.DateLabelOverride
+			meter.ValueLabelOverride = old.ValueLabelOverride
+			break
+		}
+	}
+}
+
+// restoreAlphaOnlyFields copies the deprecated fields v1beta1 cannot express
+// from the stashed spec.
+func restoreAlphaOnlyFields(dst, stashed *MeterDefinitionSpec) {
+	dst.Version = stashed.Version
+	dst.ServiceMeterLabels = stashed.ServiceMeterLabels
+	dst.PodMeterLabels = stashed.PodMeterLabels
+
+	if dst.WorkloadVertexType == "" {
+		dst.WorkloadVertexType = stashed.WorkloadVertexType
+		dst.VertexLabelSelector = stashed.VertexLabelSelector
+	}
+}
+
+func setSpecAnnotation(meta *metav1.ObjectMeta, key string, spec interface{}) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return errors.WrapWithDetails(err, "failed to marshal spec", "annotation", key)
+	}
+
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+
+	meta.Annotations[key] = string(data)
 	return nil
 }
 
+func popSpecAnnotation(meta *metav1.ObjectMeta, key string, spec interface{}) (bool, error) {
+	data, ok := meta.Annotations[key]
+	if !ok {
+		return false, nil
+	}
+
+	delete(meta.Annotations, key)
+
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+
+	if err := json.Unmarshal([]byte(data), spec); err != nil {
+		return false, errors.WrapWithDetails(err, "failed to unmarshal spec", "annotation", key)
+	}
+
+	return true, nil
+}
+
 func ConvertWorkloadTypeAlpha(typeIn interface{}) (WorkloadType, error) {
 	workloadType := v1beta1.WorkloadTypePod
 
@@ -0,0 +1,186 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"time"
+
+	fuzz "github.com/google/gofuzz"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/diff"
+)
+
+const fuzzIterations = 500
+
+func newConversionFuzzer() *fuzz.Fuzzer {
+	return fuzz.New().NilChance(.2).NumElements(0, 3).Funcs(
+		// TypeMeta is set by the conversion webhook, not the converters.
+		func(t *metav1.TypeMeta, c fuzz.Continue) {},
+		func(t *WorkloadType, c fuzz.Continue) {
+			types := []WorkloadType{WorkloadTypePod, WorkloadTypeService, WorkloadTypeServiceMonitor, WorkloadTypePVC}
+			*t = types[c.Intn(len(types))]
+		},
+		func(t *WorkloadVertex, c fuzz.Continue) {
+			vertexes := []WorkloadVertex{"", WorkloadVertexOperatorGroup, WorkloadVertexNamespace}
+			*t = vertexes[c.Intn(len(vertexes))]
+		},
+		func(t *v1beta1.WorkloadType, c fuzz.Continue) {
+			types := []v1beta1.WorkloadType{v1beta1.WorkloadTypePod, v1beta1.WorkloadTypeService, v1beta1.WorkloadTypePVC}
+			*t = types[c.Intn(len(types))]
+		},
+		func(d *metav1.Duration, c fuzz.Continue) {
+			d.Duration = time.Duration(c.Int63n(24*60)) * time.Minute
+		},
+		func(spec *v1beta1.MeterDefinitionSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(spec)
+
+			if len(spec.ResourceFilters) == 0 {
+				filter := v1beta1.ResourceFilter{}
+				c.Fuzz(&filter)
+				spec.ResourceFilters = append(spec.ResourceFilters, filter)
+			}
+		},
+	)
+}
+
+var _ = Describe("MeterDefinition conversion", func() {
+	var f *fuzz.Fuzzer
+
+	BeforeEach(func() {
+		f = newConversionFuzzer()
+	})
+
+	It("should round trip v1alpha1 -> v1beta1 -> v1alpha1", func() {
+		for i := 0; i < fuzzIterations; i++ {
+			original := &MeterDefinition{}
+			f.Fuzz(original)
+
+			hub := &v1beta1.MeterDefinition{}
+			Expect(original.DeepCopy().ConvertTo(hub)).To(Succeed())
+
+			result := &MeterDefinition{}
+			Expect(result.ConvertFrom(hub)).To(Succeed())
+
+			Expect(equality.Semantic.DeepEqual(original, result)).To(BeTrue(),
+				diff.ObjectReflectDiff(original, result))
+		}
+	})
+
+	It("should round trip v1beta1 -> v1alpha1 -> v1beta1", func() {
+		for i := 0; i < fuzzIterations; i++ {
+			original := &v1beta1.MeterDefinition{}
+			f.Fuzz(original)
+
+			spoke := &MeterDefinition{}
+			Expect(spoke.ConvertFrom(original.DeepCopy())).To(Succeed())
+
+			result := &v1beta1.MeterDefinition{}
+			Expect(spoke.ConvertTo(result)).To(Succeed())
+
+			Expect(equality.Semantic.DeepEqual(original, result)).To(BeTrue(),
+				diff.ObjectReflectDiff(original, result))
+		}
+	})
+
+	It("should keep v1beta1 only fields when the v1alpha1 object is edited", func() {
+		period := metav1.Duration{Duration: 15 * time.Minute}
+		maxSeries := 100
+		original := &v1beta1.MeterDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "bar",
+			},
+			Spec: v1beta1.MeterDefinitionSpec{
+				Group: "apps.partner.metering.com",
+				Kind:  "App",
+				ResourceFilters: []v1beta1.ResourceFilter{
+					{
+						Namespace:    &v1beta1.NamespaceFilter{UseOperatorGroup: true},
+						OwnerCRD:     &v1beta1.OwnerCRDFilter{GroupVersionKind: common.GroupVersionKind{APIVersion: "apps.partner.metering.com/v1", Kind: "App"}},
+						WorkloadType: v1beta1.WorkloadTypePod,
+					},
+				},
+				Meters: []v1beta1.MeterWorkload{
+					{
+						Metric:            "rpc_durations_seconds_sum",
+						Name:              "rpc",
+						Aggregation:       "sum",
+						Query:             "rpc_durations_seconds_sum",
+						WorkloadType:      v1beta1.WorkloadTypePod,
+						Period:            &period,
+						Without:           []string{"instance"},
+						DateLabelOverride: "date",
+					},
+				},
+				ScrapeTargets: []v1beta1.ScrapeTarget{
+					{Kind: v1beta1.ScrapeTargetServiceMonitor, Name: "app-metrics"},
+				},
+				QueryLimits: &v1beta1.MeterQueryLimits{MaxSeries: &maxSeries},
+			},
+		}
+
+		spoke := &MeterDefinition{}
+		Expect(spoke.ConvertFrom(original)).To(Succeed())
+		Expect(spoke.Annotations).To(HaveKey(MeterDefinitionBetaSpecAnnotation))
+
+		spoke.Spec.Workloads[0].MetricLabels[0].Query = "new_query"
+
+		result := &v1beta1.MeterDefinition{}
+		Expect(spoke.ConvertTo(result)).To(Succeed())
+		Expect(result.Annotations).ToNot(HaveKey(MeterDefinitionBetaSpecAnnotation))
+		Expect(result.Annotations).To(HaveKey(MeterDefinitionAlphaSpecAnnotation))
+		Expect(result.Spec.Meters).To(HaveLen(1))
+		Expect(result.Spec.Meters[0].Query).To(Equal("new_query"))
+		Expect(result.Spec.Meters[0].Without).To(Equal([]string{"instance"}))
+		Expect(result.Spec.Meters[0].DateLabelOverride).To(Equal("date"))
+		Expect(result.Spec.Meters[0].Period).To(Equal(&period))
+		Expect(result.Spec.ScrapeTargets).To(Equal(original.Spec.ScrapeTargets))
+		Expect(result.Spec.QueryLimits).To(Equal(original.Spec.QueryLimits))
+	})
+
+	It("should convert a templated meterdefinition that is not rendered yet", func() {
+		original := &v1beta1.MeterDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "bar",
+			},
+			Spec: v1beta1.MeterDefinitionSpec{
+				TemplateRef: &v1beta1.MeterDefinitionTemplateReference{
+					Name:    "pod-vcpu-usage",
+					Version: "v1",
+				},
+			},
+		}
+
+		spoke := &MeterDefinition{}
+		Expect(spoke.ConvertFrom(original.DeepCopy())).To(Succeed())
+		Expect(spoke.Spec.Workloads).To(BeEmpty())
+
+		result := &v1beta1.MeterDefinition{}
+		Expect(spoke.ConvertTo(result)).To(Succeed())
+		Expect(result.Spec.TemplateRef).To(Equal(original.Spec.TemplateRef))
+	})
+
+	It("should fail to convert a meterdefinition without filters or a template", func() {
+		spoke := &MeterDefinition{}
+		Expect(spoke.ConvertFrom(&v1beta1.MeterDefinition{})).To(MatchError(ErrNoResourceFilters))
+	})
+})
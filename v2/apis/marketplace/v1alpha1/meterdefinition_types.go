@@ -185,6 +185,16 @@ type MeterDefinitionStatus struct {
 	// Results is a list of Results that get returned from a query to prometheus
 	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
 	Results []common.Result `json:"results,omitempty"`
+
+	// Template is the template the spec was last rendered from
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	Template *common.MeterDefinitionTemplateStatus `json:"template,omitempty"`
+
+	// Meters is the reporting state of each meter, written by the reporter
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	Meters []common.MeterStatus `json:"meters,omitempty"`
 }
 
 // MeterDefinition defines the meter workloads used to enable pay for
@@ -139,7 +139,10 @@ var _ = Describe("MeterDefinition", func() {
 					"rpc_durations_seconds_count": MatchFields(IgnoreExtras, k2),
 				},
 			),
-			"InstalledBy": BeNil(),
+			"InstalledBy":   BeNil(),
+			"TemplateRef":   BeNil(),
+			"ScrapeTargets": BeEmpty(),
+			"QueryLimits":   BeNil(),
 		}))
 
 		newSource := &MeterDefinition{}
@@ -234,6 +237,9 @@ var _ = Describe("MeterDefinition", func() {
 				Name:      "name",
 				Namespace: "namespace",
 			})),
+			"TemplateRef":   BeNil(),
+			"ScrapeTargets": BeEmpty(),
+			"QueryLimits":   BeNil(),
 		}))
 
 	})
@@ -35,7 +35,20 @@ type MeterReportSpec struct {
 
 	// PrometheusService is the definition for the service labels.
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
-	PrometheusService *common.ServiceReference `json:"prometheusService"`
+	// +optional
+	PrometheusService *common.ServiceReference `json:"prometheusService,omitempty"`
+
+	// ExternalPrometheus is the query endpoint to report from when the MeterBase
+	// uses an external Prometheus. Takes precedence over PrometheusService.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	ExternalPrometheus *common.ExternalPrometheus `json:"externalPrometheus,omitempty"`
+
+	// RemoteStore is the query endpoint of the long-term store the metering
+	// series are remote written to. Used for intervals missing locally.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	RemoteStore *common.ExternalPrometheus `json:"remoteStore,omitempty"`
 
 	// MeterDefinitions is the list of meterDefinitions included in the report
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
@@ -76,6 +89,36 @@ type MeterReportStatus struct {
 	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
 	// +optional
 	QueryErrorList []string `json:"queryErrorList,omitempty"`
+
+	// DataSources lists the source that served each interval of the report.
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	DataSources []ReportDataSource `json:"dataSources,omitempty"`
+}
+
+// ReportDataSourceType is the source of the metering data of a report interval.
+type ReportDataSourceType string
+
+const (
+	// ReportDataSourceLocal is the Prometheus the MeterBase queries.
+	ReportDataSourceLocal ReportDataSourceType = "Local"
+	// ReportDataSourceRemoteWrite is the long-term store of the MeterBase remote write.
+	ReportDataSourceRemoteWrite ReportDataSourceType = "RemoteWrite"
+	// ReportDataSourceMissing means no source had data for the interval.
+	ReportDataSourceMissing ReportDataSourceType = "Missing"
+)
+
+// ReportDataSource is the source that served an interval of the report.
+type ReportDataSource struct {
+	// Start of the interval
+	Start metav1.Time `json:"start"`
+
+	// End of the interval
+	End metav1.Time `json:"end"`
+
+	// Source of the data for the interval
+	// +kubebuilder:validation:Enum=Local;RemoteWrite;Missing
+	Source ReportDataSourceType `json:"source"`
 }
 
 const (
@@ -85,6 +128,7 @@ const (
 	ReportConditionReasonJobWaiting    status.ConditionReason = "Waiting"
 	ReportConditionReasonJobFinished   status.ConditionReason = "Finished"
 	ReportConditionReasonJobErrored    status.ConditionReason = "Errored"
+	ReportConditionReasonJobDisabled   status.ConditionReason = "Disabled"
 )
 
 var (
@@ -118,6 +162,12 @@ var (
 		Reason:  ReportConditionReasonJobErrored,
 		Message: "Job has errored",
 	}
+	ReportConditionJobDisabled = status.Condition{
+		Type:    ReportConditionTypeJobRunning,
+		Status:  corev1.ConditionFalse,
+		Reason:  ReportConditionReasonJobDisabled,
+		Message: "Reporting is disabled",
+	}
 )
 
 // +kubebuilder:object:root=true
@@ -226,4 +226,6 @@ const (
 	ReasonRhmRemoteResourceS3DeploymentInstalled status.ConditionReason = "FinishedRemoteResourceS3DeploymentInstall"
 	ReasonRhmRemoteResourceS3DeploymentEnabled   status.ConditionReason = "EnabledRemoteResourceS3DeploymentInstall"
 	ReasonRhmRegistrationWatchkeeperEnabled      status.ConditionReason = "EnabledRegistrationWatchkeeperInstall"
+	ReasonRhmRegistrationNativeReporterEnabled   status.ConditionReason = "EnabledRegistrationNativeReporter"
+	ReasonRhmRemoteResourceS3VerifiedEnabled     status.ConditionReason = "EnabledVerifiedRemoteResourceS3"
 )
@@ -112,6 +112,12 @@ type Request struct {
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	// +optional
 	Optional bool `json:"optional,omitempty"`
+	// PinnedDigest is the digest the content of the request must have, as
+	// sha256:<hex>. Content with another digest is refused.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	// +optional
+	PinnedDigest string `json:"pinnedDigest,omitempty"`
 }
 
 //Options holds the options object which will be passed as-is to the http request. Allows you to specify things like headers for authentication.
@@ -154,6 +160,66 @@ type RemoteResourceS3Status struct {
 	Touched *bool `json:"touched,omitempty"`
 	// RazeeLogs is the logs from the controller
 	RazeeLogs RazeeLogs `json:"razee-logs,omitempty"`
+	// Fetches is the history of the content fetched for the requests, the
+	// latest last.
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	Fetches []RemoteResourceFetch `json:"fetches,omitempty"`
+}
+
+// RemoteResourceFetchHistory is the most fetches kept in the status.
+const RemoteResourceFetchHistory = 20
+
+// FetchResult is what was done with fetched content.
+type FetchResult string
+
+const (
+	// FetchResultApplied content was verified and applied.
+	FetchResultApplied FetchResult = "Applied"
+	// FetchResultRefused content failed verification and wasn't applied.
+	FetchResultRefused FetchResult = "Refused"
+	// FetchResultFailed content couldn't be fetched or applied.
+	FetchResultFailed FetchResult = "Failed"
+)
+
+// RemoteResourceFetch records the content fetched for a request.
+type RemoteResourceFetch struct {
+	// URL of the request
+	URL string `json:"url"`
+	// Digest of the content as sha256:<hex>, empty if it wasn't fetched.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+	// Result is what was done with the content.
+	Result FetchResult `json:"result"`
+	// Message explains the result.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Time of the fetch.
+	Time metav1.Time `json:"time"`
+}
+
+// RecordFetch adds the fetch to the history unless the last fetch of the
+// URL had the same digest and result, so the history shows the changes
+// of the content. Returns true if the fetch was added.
+func (s *RemoteResourceS3Status) RecordFetch(fetch RemoteResourceFetch) bool {
+	for i := len(s.Fetches) - 1; i >= 0; i-- {
+		last := s.Fetches[i]
+		if last.URL != fetch.URL {
+			continue
+		}
+
+		if last.Digest == fetch.Digest && last.Result == fetch.Result && last.Message == fetch.Message {
+			return false
+		}
+		break
+	}
+
+	s.Fetches = append(s.Fetches, fetch)
+	if len(s.Fetches) > RemoteResourceFetchHistory {
+		s.Fetches = s.Fetches[len(s.Fetches)-RemoteResourceFetchHistory:]
+	}
+
+	return true
 }
 
 // RazeeLogs holds log output from the RRS3 controller
@@ -108,6 +108,107 @@ func (in *CSVNamespacedName) DeepCopy() *CSVNamespacedName {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogSourceConfig) DeepCopyInto(out *CatalogSourceConfig) {
+	*out = *in
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogSourceConfig.
+func (in *CatalogSourceConfig) DeepCopy() *CatalogSourceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogSourceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRegistrationStatus) DeepCopyInto(out *ClusterRegistrationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(status.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TokenExpiry != nil {
+		in, out := &in.TokenExpiry, &out.TokenExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.LastContactTime != nil {
+		in, out := &in.LastContactTime, &out.LastContactTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRegistrationStatus.
+func (in *ClusterRegistrationStatus) DeepCopy() *ClusterRegistrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRegistrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerResourceRecommendation) DeepCopyInto(out *ContainerResourceRecommendation) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerResourceRecommendation.
+func (in *ContainerResourceRecommendation) DeepCopy() *ContainerResourceRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerResourceRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DecommissionStatus) DeepCopyInto(out *DecommissionStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DecommissionStatus.
+func (in *DecommissionStatus) DeepCopy() *DecommissionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DecommissionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureGateStatus) DeepCopyInto(out *FeatureGateStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureGateStatus.
+func (in *FeatureGateStatus) DeepCopy() *FeatureGateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureGateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Header) DeepCopyInto(out *Header) {
 	{
@@ -270,6 +371,18 @@ func (in *MarketplaceConfigSpec) DeepCopyInto(out *MarketplaceConfigSpec) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WatchResourcePolicy != nil {
+		in, out := &in.WatchResourcePolicy, &out.WatchResourcePolicy
+		*out = new(WatchResourcePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CatalogSources != nil {
+		in, out := &in.CatalogSources, &out.CatalogSources
+		*out = make([]CatalogSourceConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MarketplaceConfigSpec.
@@ -306,6 +419,26 @@ func (in *MarketplaceConfigStatus) DeepCopyInto(out *MarketplaceConfigStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Registration != nil {
+		in, out := &in.Registration, &out.Registration
+		*out = new(ClusterRegistrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Decommission != nil {
+		in, out := &in.Decommission, &out.Decommission
+		*out = new(DecommissionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make([]FeatureGateStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.WatchResourceKinds != nil {
+		in, out := &in.WatchResourceKinds, &out.WatchResourceKinds
+		*out = make([]WatchResourceKind, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MarketplaceConfigStatus.
@@ -385,6 +518,16 @@ func (in *MeterBaseSpec) DeepCopyInto(out *MeterBaseSpec) {
 		*out = new(PrometheusSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExternalPrometheus != nil {
+		in, out := &in.ExternalPrometheus, &out.ExternalPrometheus
+		*out = new(common.ExternalPrometheus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalPrometheusRef != nil {
+		in, out := &in.ExternalPrometheusRef, &out.ExternalPrometheusRef
+		*out = new(common.NamespacedNameReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdditionalScrapeConfigs != nil {
 		in, out := &in.AdditionalScrapeConfigs, &out.AdditionalScrapeConfigs
 		*out = new(corev1.SecretKeySelector)
@@ -437,6 +580,16 @@ func (in *MeterBaseStatus) DeepCopyInto(out *MeterBaseStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.EstimatedStorage != nil {
+		in, out := &in.EstimatedStorage, &out.EstimatedStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = new(ResourceRecommendations)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterBaseStatus.
@@ -579,6 +732,18 @@ func (in *MeterDefinitionStatus) DeepCopyInto(out *MeterDefinitionStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(common.MeterDefinitionTemplateStatus)
+		**out = **in
+	}
+	if in.Meters != nil {
+		in, out := &in.Meters, &out.Meters
+		*out = make([]common.MeterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterDefinitionStatus.
@@ -675,6 +840,16 @@ func (in *MeterReportSpec) DeepCopyInto(out *MeterReportSpec) {
 		*out = new(common.ServiceReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExternalPrometheus != nil {
+		in, out := &in.ExternalPrometheus, &out.ExternalPrometheus
+		*out = new(common.ExternalPrometheus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemoteStore != nil {
+		in, out := &in.RemoteStore, &out.RemoteStore
+		*out = new(common.ExternalPrometheus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MeterDefinitions != nil {
 		in, out := &in.MeterDefinitions, &out.MeterDefinitions
 		*out = make([]MeterDefinition, len(*in))
@@ -729,6 +904,13 @@ func (in *MeterReportStatus) DeepCopyInto(out *MeterReportStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DataSources != nil {
+		in, out := &in.DataSources, &out.DataSources
+		*out = make([]ReportDataSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterReportStatus.
@@ -741,6 +923,41 @@ func (in *MeterReportStatus) DeepCopy() *MeterReportStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWriteSpec) DeepCopyInto(out *PrometheusRemoteWriteSpec) {
+	*out = *in
+	if in.BearerTokenSecret != nil {
+		in, out := &in.BearerTokenSecret, &out.BearerTokenSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(monitoringv1.BasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSConfig != nil {
+		in, out := &in.TLSConfig, &out.TLSConfig
+		*out = new(monitoringv1.SafeTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(common.ExternalPrometheus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWriteSpec.
+func (in *PrometheusRemoteWriteSpec) DeepCopy() *PrometheusRemoteWriteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWriteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 	*out = *in
@@ -758,6 +975,11 @@ func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RemoteWrite != nil {
+		in, out := &in.RemoteWrite, &out.RemoteWrite
+		*out = new(PrometheusRemoteWriteSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusSpec.
@@ -1003,6 +1225,22 @@ func (in *RazeeLogs) DeepCopy() *RazeeLogs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteResourceFetch) DeepCopyInto(out *RemoteResourceFetch) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteResourceFetch.
+func (in *RemoteResourceFetch) DeepCopy() *RemoteResourceFetch {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteResourceFetch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteResourceS3) DeepCopyInto(out *RemoteResourceS3) {
 	*out = *in
@@ -1094,6 +1332,13 @@ func (in *RemoteResourceS3Status) DeepCopyInto(out *RemoteResourceS3Status) {
 		**out = **in
 	}
 	in.RazeeLogs.DeepCopyInto(&out.RazeeLogs)
+	if in.Fetches != nil {
+		in, out := &in.Fetches, &out.Fetches
+		*out = make([]RemoteResourceFetch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteResourceS3Status.
@@ -1106,6 +1351,23 @@ func (in *RemoteResourceS3Status) DeepCopy() *RemoteResourceS3Status {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportDataSource) DeepCopyInto(out *ReportDataSource) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportDataSource.
+func (in *ReportDataSource) DeepCopy() *ReportDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Request) DeepCopyInto(out *Request) {
 	*out = *in
@@ -1122,6 +1384,29 @@ func (in *Request) DeepCopy() *Request {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecommendations) DeepCopyInto(out *ResourceRecommendations) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ContainerResourceRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecommendations.
+func (in *ResourceRecommendations) DeepCopy() *ResourceRecommendations {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecommendations)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3Options) DeepCopyInto(out *S3Options) {
 	*out = *in
@@ -1212,6 +1497,63 @@ func (in *ValueFrom) DeepCopy() *ValueFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatchResourceKind) DeepCopyInto(out *WatchResourceKind) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchResourceKind.
+func (in *WatchResourceKind) DeepCopy() *WatchResourceKind {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchResourceKind)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatchResourcePolicy) DeepCopyInto(out *WatchResourcePolicy) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]WatchResourceRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchResourcePolicy.
+func (in *WatchResourcePolicy) DeepCopy() *WatchResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatchResourceRule) DeepCopyInto(out *WatchResourceRule) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchResourceRule.
+func (in *WatchResourceRule) DeepCopy() *WatchResourceRule {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchResourceRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workload) DeepCopyInto(out *Workload) {
 	*out = *in
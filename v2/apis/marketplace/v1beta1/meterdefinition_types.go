@@ -33,26 +33,35 @@ type MeterDefinitionSpec struct {
 	// Group defines the operator group of the meter
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:com.tectonic.ui:text"
-	Group string `json:"group"`
+	// +optional
+	Group string `json:"group,omitempty"`
 
 	// Kind defines the primary CRD kind of the meter
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:com.tectonic.ui:text"
-	Kind string `json:"kind"`
+	// +optional
+	Kind string `json:"kind,omitempty"`
 
 	// ResourceFilters provide filters that will be used to find the workload objects.
 	// This is to find the exact resources the query is interested in. At least one must
-	// be provided.
+	// be provided unless a template is referenced.
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
-	// +kubebuilder:validation:MinItems:=1
-	ResourceFilters []ResourceFilter `json:"resourceFilters"`
+	// +optional
+	ResourceFilters []ResourceFilter `json:"resourceFilters,omitempty"`
 
 	// Meters are the definitions related to the metrics that you would like to monitor.
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:com.tectonic.ui:text"
 	// +patchMergeKey=metricId
 	// +patchStrategy=merge
-	Meters []MeterWorkload `json:"meters"`
+	// +optional
+	Meters []MeterWorkload `json:"meters,omitempty"`
+
+	// TemplateRef references a MeterDefinitionTemplate to render this spec from.
+	// When set, the group, kind, resource filters and meters are managed by the operator.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	TemplateRef *MeterDefinitionTemplateReference `json:"templateRef,omitempty"`
 
 	// InstalledBy is a reference to the CSV that install the meter
 	// definition. This is used to determine an operator group.
@@ -60,8 +69,57 @@ type MeterDefinitionSpec struct {
 	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:com.tectonic.ui:hidden"
 	// +optional
 	InstalledBy *common.NamespacedNameReference `json:"installedBy,omitempty"`
+
+	// ScrapeTargets are ServiceMonitors or PodMonitors in the namespace of the meter
+	// definition for the metering Prometheus to scrape. Only the metrics the meters
+	// query are kept.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	ScrapeTargets []ScrapeTarget `json:"scrapeTargets,omitempty"`
+
+	// QueryLimits override the default limits of the cost of the meter queries
+	// run by the operator and the reporter. The defaults set no limit.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	QueryLimits *MeterQueryLimits `json:"queryLimits,omitempty"`
+}
+
+// MeterQueryLimits bound the cost of a meter query. Limits that are not set
+// use the defaults.
+type MeterQueryLimits struct {
+	// MaxSeries is the most series a meter query may return, 0 disables the limit.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MaxSeries *int `json:"maxSeries,omitempty"`
+
+	// MaxSamples is the most samples a meter query may return over a report,
+	// 0 disables the limit.
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	MaxSamples *int `json:"maxSamples,omitempty"`
+
+	// Timeout of a meter query.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ScrapeTarget references a monitor in the namespace of the meter definition.
+type ScrapeTarget struct {
+	// Kind of the monitor.
+	// +kubebuilder:validation:Enum:=ServiceMonitor;PodMonitor
+	Kind ScrapeTargetKind `json:"kind"`
+
+	// Name of the monitor.
+	Name string `json:"name"`
 }
 
+type ScrapeTargetKind string
+
+const (
+	ScrapeTargetServiceMonitor ScrapeTargetKind = "ServiceMonitor"
+	ScrapeTargetPodMonitor     ScrapeTargetKind = "PodMonitor"
+)
+
 const (
 	WorkloadVertexOperatorGroup WorkloadVertex = "OperatorGroup"
 	WorkloadVertexNamespace                    = "Namespace"
@@ -74,6 +132,10 @@ const (
 const (
 	ReconcileError                 status.ConditionType = "Reconcile Error"
 	MeterDefQueryPreviewSetupError status.ConditionType = "QueryPreviewSetupError"
+	MeterDefTemplateRenderError    status.ConditionType = "TemplateRenderError"
+	MeterDefQueryLimitExceeded     status.ConditionType = "QueryLimitExceeded"
+	MeterDefInstallerNotFound      status.ConditionType = "InstallerNotFound"
+	MeterDefScrapeTargetRejected   status.ConditionType = "ScrapeTargetRejected"
 )
 
 type WorkloadVertex string
@@ -226,6 +288,16 @@ type MeterDefinitionStatus struct {
 	// Results is a list of Results that get returned from a query to prometheus
 	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
 	Results []common.Result `json:"results,omitempty"`
+
+	// Template is the template the spec was last rendered from
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	Template *common.MeterDefinitionTemplateStatus `json:"template,omitempty"`
+
+	// Meters is the reporting state of each meter, written by the reporter
+	// +operator-sdk:gen-csv:customresourcedefinitions.statusDescriptors=true
+	// +optional
+	Meters []common.MeterStatus `json:"meters,omitempty"`
 }
 
 // MeterDefinition defines the meter workloads used to enable pay for
@@ -294,6 +366,15 @@ func (meterdef *MeterDefinition) ToPrometheusLabels() []*common.MeterDefPromethe
 			ValueLabelOverride: meter.ValueLabelOverride,
 		}
 
+		if limits := meterdef.Spec.QueryLimits; limits != nil {
+			obj.QueryMaxSeries = limits.MaxSeries
+			obj.QueryMaxSamples = limits.MaxSamples
+
+			if limits.Timeout != nil {
+				obj.QueryTimeout = &common.MetricPeriod{Duration: limits.Timeout.Duration}
+			}
+		}
+
 		allMdefs = append(allMdefs, obj)
 	}
 
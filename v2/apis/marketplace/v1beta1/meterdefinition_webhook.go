@@ -67,7 +67,21 @@ func (r *MeterDefinition) ValidateCreate() error {
 		}
 	}
 
+	if r.Spec.TemplateRef == nil && len(r.Spec.ResourceFilters) == 0 {
+		allErrs = append(allErrs, field.Required(
+			field.NewPath("spec").Child("resourceFilters"),
+			"resource filters must be provided if no template is referenced",
+		))
+	}
+
 	if r.IsSigned() {
+		if r.Spec.TemplateRef != nil {
+			allErrs = append(allErrs, field.Forbidden(
+				field.NewPath("spec").Child("templateRef"),
+				"signed meter definitions cannot reference a template",
+			))
+		}
+
 		// Check required fields which may not be mutated on signed MeterDefinitions
 		for _, resourceFilter := range r.Spec.ResourceFilters {
 			if resourceFilter.Namespace == nil {
@@ -127,7 +141,21 @@ func (r *MeterDefinition) ValidateUpdate(old runtime.Object) error {
 		}
 	}
 
+	if r.Spec.TemplateRef == nil && len(r.Spec.ResourceFilters) == 0 {
+		allErrs = append(allErrs, field.Required(
+			field.NewPath("spec").Child("resourceFilters"),
+			"resource filters must be provided if no template is referenced",
+		))
+	}
+
 	if r.IsSigned() {
+		if r.Spec.TemplateRef != nil {
+			allErrs = append(allErrs, field.Forbidden(
+				field.NewPath("spec").Child("templateRef"),
+				"signed meter definitions cannot reference a template",
+			))
+		}
+
 		// Check required fields which may not be mutated on signed MeterDefinitions
 		for _, resourceFilter := range r.Spec.ResourceFilters {
 			if resourceFilter.Namespace == nil {
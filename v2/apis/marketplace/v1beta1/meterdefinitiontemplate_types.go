@@ -0,0 +1,225 @@
+/*
+Copyright 2021 IBM Co..
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"bytes"
+	"text/template"
+
+	"emperror.dev/errors"
+	sprig "github.com/Masterminds/sprig/v3"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// MeterDefinitionTemplateSpec defines a shared, versioned MeterDefinition
+// that namespaced MeterDefinitions can reference by name.
+// +k8s:openapi-gen=true
+type MeterDefinitionTemplateSpec struct {
+	// Versions of the template. MeterDefinitions that do not pin a version
+	// use the last version in the list.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +kubebuilder:validation:MinItems:=1
+	Versions []MeterDefinitionTemplateVersion `json:"versions"`
+}
+
+// MeterDefinitionTemplateVersion is a single version of a template.
+type MeterDefinitionTemplateVersion struct {
+	// Version is the identifier MeterDefinitions use to pin the template.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	Version string `json:"version"`
+
+	// Parameters the template accepts.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	Parameters []MeterDefinitionTemplateParameter `json:"parameters,omitempty"`
+
+	// Template is a go template that renders to a MeterDefinition spec in yaml or json.
+	// Parameters are available as {{ .Parameters.name }}, the referencing MeterDefinition
+	// as {{ .Name }} and {{ .Namespace }}. Sprig functions are supported.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	Template string `json:"template"`
+}
+
+// MeterDefinitionTemplateParameter declares a parameter of a template.
+type MeterDefinitionTemplateParameter struct {
+	// Name of the parameter.
+	Name string `json:"name"`
+
+	// Description of the parameter for humans to read.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Required parameters must be provided by the MeterDefinition if there is no default.
+	// +optional
+	Required bool `json:"required,omitempty"`
+
+	// Default is used when the MeterDefinition does not provide the parameter.
+	// +optional
+	Default string `json:"default,omitempty"`
+}
+
+// MeterDefinitionTemplateReference points a MeterDefinition at a template.
+type MeterDefinitionTemplateReference struct {
+	// Name of the MeterDefinitionTemplate.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors.x-descriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	Name string `json:"name"`
+
+	// Version of the template to render. The latest version is used if omitted.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Parameters passed to the template.
+	// +operator-sdk:gen-csv:customresourcedefinitions.specDescriptors=true
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// MeterDefinitionTemplate is a cluster wide library entry of a parameterized
+// MeterDefinition.
+// +kubebuilder:object:root=true
+//
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:path=meterdefinitiontemplates,scope=Cluster
+// +operator-sdk:gen-csv:customresourcedefinitions.displayName="Meter Definition Templates"
+// +genclient
+// +genclient:nonNamespaced
+type MeterDefinitionTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MeterDefinitionTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MeterDefinitionTemplateList contains a list of MeterDefinitionTemplate
+type MeterDefinitionTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MeterDefinitionTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MeterDefinitionTemplate{}, &MeterDefinitionTemplateList{})
+}
+
+const (
+	TemplateVersionNotFound    = errors.Sentinel("template version not found")
+	TemplateParameterMissing   = errors.Sentinel("required template parameter is missing")
+	TemplateRenderedSpecNotSet = errors.Sentinel("rendered template has no resource filters")
+)
+
+// GetVersion returns the requested version of the template, or the
+// latest version if version is empty.
+func (t *MeterDefinitionTemplate) GetVersion(version string) (*MeterDefinitionTemplateVersion, error) {
+	if len(t.Spec.Versions) == 0 {
+		return nil, errors.WithDetails(TemplateVersionNotFound, "template", t.Name)
+	}
+
+	if version == "" {
+		return &t.Spec.Versions[len(t.Spec.Versions)-1], nil
+	}
+
+	for i := range t.Spec.Versions {
+		if t.Spec.Versions[i].Version == version {
+			return &t.Spec.Versions[i], nil
+		}
+	}
+
+	return nil, errors.WithDetails(TemplateVersionNotFound, "template", t.Name, "version", version)
+}
+
+// Render renders the template for the meterdefinition and returns the
+// rendered spec along with the status to record on the meterdefinition.
+func (t *MeterDefinitionTemplate) Render(meterdef *MeterDefinition) (*MeterDefinitionSpec, *common.MeterDefinitionTemplateStatus, error) {
+	ref := meterdef.Spec.TemplateRef
+
+	if ref == nil {
+		return nil, nil, errors.New("meterdefinition does not reference a template")
+	}
+
+	version, err := t.GetVersion(ref.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := map[string]string{}
+
+	for _, param := range version.Parameters {
+		value, ok := ref.Parameters[param.Name]
+
+		switch {
+		case ok:
+			params[param.Name] = value
+		case param.Default != "":
+			params[param.Name] = param.Default
+		case param.Required:
+			return nil, nil, errors.WithDetails(TemplateParameterMissing,
+				"template", t.Name, "version", version.Version, "parameter", param.Name)
+		default:
+			params[param.Name] = ""
+		}
+	}
+
+	tmpl, err := template.New(t.Name).
+		Funcs(sprig.GenericFuncMap()).
+		Option("missingkey=error").
+		Parse(version.Template)
+	if err != nil {
+		return nil, nil, errors.WrapWithDetails(err, "failed to parse template", "template", t.Name, "version", version.Version)
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, map[string]interface{}{
+		"Name":       meterdef.Name,
+		"Namespace":  meterdef.Namespace,
+		"Parameters": params,
+	})
+	if err != nil {
+		return nil, nil, errors.WrapWithDetails(err, "failed to render template", "template", t.Name, "version", version.Version)
+	}
+
+	rendered := &MeterDefinitionSpec{}
+	err = yaml.NewYAMLOrJSONDecoder(bytes.NewReader(buf.Bytes()), 100).Decode(rendered)
+	if err != nil {
+		return nil, nil, errors.WrapWithDetails(err, "failed to decode rendered template", "template", t.Name, "version", version.Version)
+	}
+
+	if len(rendered.ResourceFilters) == 0 {
+		return nil, nil, errors.WithDetails(TemplateRenderedSpecNotSet, "template", t.Name, "version", version.Version)
+	}
+
+	// only the template managed fields are replaced, everything else
+	// set on the meterdefinition is kept as is
+	spec := meterdef.Spec.DeepCopy()
+	spec.Group = rendered.Group
+	spec.Kind = rendered.Kind
+	spec.ResourceFilters = rendered.ResourceFilters
+	spec.Meters = rendered.Meters
+
+	return spec, &common.MeterDefinitionTemplateStatus{
+		Name:               t.Name,
+		Version:            version.Version,
+		ObservedGeneration: t.Generation,
+		RenderedSpec:       buf.String(),
+	}, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"emperror.dev/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("meterdefinitiontemplate", func() {
+	var (
+		tmpl     *MeterDefinitionTemplate
+		meterdef *MeterDefinition
+	)
+
+	const templateV1 = `group: {{ .Parameters.group }}
+kind: {{ .Parameters.kind }}
+resourceFilters:
+  - namespace:
+      useOperatorGroup: true
+    ownerCRD:
+      apiVersion: {{ .Parameters.group }}/v1
+      kind: {{ .Parameters.kind }}
+    workloadType: Pod
+meters:
+  - aggregation: sum
+    period: 1h
+    metricId: {{ .Parameters.metric }}
+    query: kube_pod_container_resource_requests{resource="cpu",namespace="{{ .Namespace }}"}
+    workloadType: Pod
+`
+
+	BeforeEach(func() {
+		tmpl = &MeterDefinitionTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "pod-vcpu-usage",
+				Generation: 2,
+			},
+			Spec: MeterDefinitionTemplateSpec{
+				Versions: []MeterDefinitionTemplateVersion{
+					{
+						Version: "v1",
+						Parameters: []MeterDefinitionTemplateParameter{
+							{Name: "group", Required: true},
+							{Name: "kind", Required: true},
+							{Name: "metric", Default: "pod_vcpu_usage"},
+						},
+						Template: templateV1,
+					},
+					{
+						Version: "v2",
+						Parameters: []MeterDefinitionTemplateParameter{
+							{Name: "group", Required: true},
+							{Name: "kind", Required: true},
+							{Name: "metric", Default: "pod_vcpu_usage_v2"},
+						},
+						Template: templateV1,
+					},
+				},
+			},
+		}
+
+		meterdef = &MeterDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "bar",
+			},
+			Spec: MeterDefinitionSpec{
+				TemplateRef: &MeterDefinitionTemplateReference{
+					Name:    "pod-vcpu-usage",
+					Version: "v1",
+					Parameters: map[string]string{
+						"group": "partner.metering.com",
+						"kind":  "App",
+					},
+				},
+			},
+		}
+	})
+
+	It("should render the pinned version", func() {
+		spec, status, err := tmpl.Render(meterdef)
+		Expect(err).To(Succeed())
+
+		Expect(spec.Group).To(Equal("partner.metering.com"))
+		Expect(spec.Kind).To(Equal("App"))
+		Expect(spec.TemplateRef).To(Equal(meterdef.Spec.TemplateRef))
+		Expect(spec.ResourceFilters).To(HaveLen(1))
+		Expect(spec.ResourceFilters[0].OwnerCRD.APIVersion).To(Equal("partner.metering.com/v1"))
+		Expect(spec.Meters).To(HaveLen(1))
+		Expect(spec.Meters[0].Metric).To(Equal("pod_vcpu_usage"))
+		Expect(spec.Meters[0].Query).To(ContainSubstring(`namespace="bar"`))
+
+		Expect(status.Name).To(Equal("pod-vcpu-usage"))
+		Expect(status.Version).To(Equal("v1"))
+		Expect(status.ObservedGeneration).To(Equal(int64(2)))
+		Expect(status.RenderedSpec).To(ContainSubstring("group: partner.metering.com"))
+	})
+
+	It("should render the latest version if none is pinned", func() {
+		meterdef.Spec.TemplateRef.Version = ""
+
+		spec, status, err := tmpl.Render(meterdef)
+		Expect(err).To(Succeed())
+		Expect(status.Version).To(Equal("v2"))
+		Expect(spec.Meters[0].Metric).To(Equal("pod_vcpu_usage_v2"))
+	})
+
+	It("should keep the fields the template does not manage", func() {
+		meterdef.Spec.Group = "stale.metering.com"
+		meterdef.Spec.Meters = []MeterWorkload{{Metric: "stale", Query: "stale"}}
+		meterdef.Spec.InstalledBy = &common.NamespacedNameReference{
+			Namespace: "bar",
+			Name:      "partner-operator.v1.0.0",
+		}
+
+		spec, _, err := tmpl.Render(meterdef)
+		Expect(err).To(Succeed())
+		Expect(spec.Group).To(Equal("partner.metering.com"))
+		Expect(spec.Meters).To(HaveLen(1))
+		Expect(spec.Meters[0].Metric).To(Equal("pod_vcpu_usage"))
+		Expect(spec.InstalledBy).To(Equal(meterdef.Spec.InstalledBy))
+		Expect(meterdef.Spec.Group).To(Equal("stale.metering.com"))
+	})
+
+	It("should fail on a missing version", func() {
+		meterdef.Spec.TemplateRef.Version = "v3"
+
+		_, _, err := tmpl.Render(meterdef)
+		Expect(errors.Is(err, TemplateVersionNotFound)).To(BeTrue())
+	})
+
+	It("should fail on a missing required parameter", func() {
+		delete(meterdef.Spec.TemplateRef.Parameters, "kind")
+
+		_, _, err := tmpl.Render(meterdef)
+		Expect(errors.Is(err, TemplateParameterMissing)).To(BeTrue())
+	})
+
+	It("should fail on an undeclared parameter", func() {
+		tmpl.Spec.Versions[0].Template = templateV1 + "# {{ .Parameters.unknown }}"
+
+		_, _, err := tmpl.Render(meterdef)
+		Expect(err).To(HaveOccurred())
+	})
+})
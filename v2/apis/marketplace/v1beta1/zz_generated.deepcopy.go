@@ -163,11 +163,26 @@ func (in *MeterDefinitionSpec) DeepCopyInto(out *MeterDefinitionSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(MeterDefinitionTemplateReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InstalledBy != nil {
 		in, out := &in.InstalledBy, &out.InstalledBy
 		*out = new(common.NamespacedNameReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ScrapeTargets != nil {
+		in, out := &in.ScrapeTargets, &out.ScrapeTargets
+		*out = make([]ScrapeTarget, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryLimits != nil {
+		in, out := &in.QueryLimits, &out.QueryLimits
+		*out = new(MeterQueryLimits)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterDefinitionSpec.
@@ -204,6 +219,18 @@ func (in *MeterDefinitionStatus) DeepCopyInto(out *MeterDefinitionStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(common.MeterDefinitionTemplateStatus)
+		**out = **in
+	}
+	if in.Meters != nil {
+		in, out := &in.Meters, &out.Meters
+		*out = make([]common.MeterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterDefinitionStatus.
@@ -216,6 +243,173 @@ func (in *MeterDefinitionStatus) DeepCopy() *MeterDefinitionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeterDefinitionTemplate) DeepCopyInto(out *MeterDefinitionTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterDefinitionTemplate.
+func (in *MeterDefinitionTemplate) DeepCopy() *MeterDefinitionTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(MeterDefinitionTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeterDefinitionTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeterDefinitionTemplateList) DeepCopyInto(out *MeterDefinitionTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeterDefinitionTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterDefinitionTemplateList.
+func (in *MeterDefinitionTemplateList) DeepCopy() *MeterDefinitionTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeterDefinitionTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeterDefinitionTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeterDefinitionTemplateParameter) DeepCopyInto(out *MeterDefinitionTemplateParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterDefinitionTemplateParameter.
+func (in *MeterDefinitionTemplateParameter) DeepCopy() *MeterDefinitionTemplateParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(MeterDefinitionTemplateParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeterDefinitionTemplateReference) DeepCopyInto(out *MeterDefinitionTemplateReference) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterDefinitionTemplateReference.
+func (in *MeterDefinitionTemplateReference) DeepCopy() *MeterDefinitionTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(MeterDefinitionTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeterDefinitionTemplateSpec) DeepCopyInto(out *MeterDefinitionTemplateSpec) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]MeterDefinitionTemplateVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterDefinitionTemplateSpec.
+func (in *MeterDefinitionTemplateSpec) DeepCopy() *MeterDefinitionTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeterDefinitionTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeterDefinitionTemplateVersion) DeepCopyInto(out *MeterDefinitionTemplateVersion) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]MeterDefinitionTemplateParameter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterDefinitionTemplateVersion.
+func (in *MeterDefinitionTemplateVersion) DeepCopy() *MeterDefinitionTemplateVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(MeterDefinitionTemplateVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeterQueryLimits) DeepCopyInto(out *MeterQueryLimits) {
+	*out = *in
+	if in.MaxSeries != nil {
+		in, out := &in.MaxSeries, &out.MaxSeries
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxSamples != nil {
+		in, out := &in.MaxSamples, &out.MaxSamples
+		*out = new(int)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeterQueryLimits.
+func (in *MeterQueryLimits) DeepCopy() *MeterQueryLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(MeterQueryLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MeterWorkload) DeepCopyInto(out *MeterWorkload) {
 	*out = *in
@@ -316,3 +510,18 @@ func (in *ResourceFilter) DeepCopy() *ResourceFilter {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScrapeTarget) DeepCopyInto(out *ScrapeTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScrapeTarget.
+func (in *ScrapeTarget) DeepCopy() *ScrapeTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ScrapeTarget)
+	in.DeepCopyInto(out)
+	return out
+}
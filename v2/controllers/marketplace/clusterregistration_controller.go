@@ -17,34 +17,54 @@ package marketplace
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
+	"sort"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	"github.com/prometheus/client_golang/prometheus"
 	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/marketplace"
 	mktypes "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/types"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/predicates"
+	status "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/status"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/version"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // blank assignment to verify that ReconcileClusterRegistration implements reconcile.Reconciler
 var _ reconcile.Reconciler = &ClusterRegistrationReconciler{}
 
+var pullSecretExpiry = prometheus.NewGauge(prometheus.GaugeOpts{
+	Name: "rhm_pull_secret_expiry_seconds",
+	Help: "Unix time the token of the RHM pull secret expires at.",
+})
+
+func init() {
+	metrics.Registry.MustRegister(pullSecretExpiry)
+}
+
 // ClusterRegistrationReconciler reconciles a Registration object
 type ClusterRegistrationReconciler struct {
 	// This client, initialized using mgr.Client() above, is a split client
@@ -55,6 +75,7 @@ type ClusterRegistrationReconciler struct {
 
 	cfg            *config.OperatorConfig
 	mclientBuilder *marketplace.MarketplaceClientBuilder
+	recorder       record.EventRecorder
 }
 
 // Reconcile reads that state of the cluster for a ClusterRegistration object and makes changes based on the state read
@@ -85,9 +106,39 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 	}
 	reqLogger.Info("redhat-marketplace-pull-secret Secret found")
 
+	// the progress is kept on the marketplace config once it is created, the
+	// annotations of the pull secret are kept for compatibility
+	newMarketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
+	err = r.Client.Get(context.TODO(), types.NamespacedName{
+		Namespace: request.Namespace,
+		Name:      "marketplaceconfig",
+	}, newMarketplaceConfig)
+
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			reqLogger.Error(err, "failed to get marketplaceconfig")
+			return reconcile.Result{}, err
+		}
+
+		newMarketplaceConfig = nil
+	}
+
+	registration := &marketplacev1alpha1.ClusterRegistrationStatus{}
+	if newMarketplaceConfig != nil && newMarketplaceConfig.Status.Registration != nil {
+		registration = newMarketplaceConfig.Status.Registration.DeepCopy()
+	}
+
 	// Check condition if 'PULL_SECRET' key is missing in secret
 	if _, ok := rhmPullSecret.Data[utils.RHMPullSecretKey]; !ok {
 		reqLogger.Info("Missing token filed in secret")
+		r.setRegistrationCondition(&rhmPullSecret, registration, status.Condition{
+			Type:    marketplacev1alpha1.ConditionTokenValid,
+			Status:  v1.ConditionFalse,
+			Reason:  marketplacev1alpha1.ReasonTokenMissing,
+			Message: "key with name 'PULL_SECRET' is missing in secret",
+		})
+		r.updateRegistrationStatus(reqLogger, newMarketplaceConfig, registration)
+
 		annotations[utils.RHMPullSecretStatus] = "error"
 		annotations[utils.RHMPullSecretMessage] = "key with name 'PULL_SECRET' is missing in secret"
 		rhmPullSecret.SetAnnotations(annotations)
@@ -100,8 +151,29 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 
 	//Get Account Id from Pull Secret Token
 	tokenClaims, err := marketplace.GetJWTTokenClaim(string(rhmPullSecret.Data[utils.RHMPullSecretKey]))
+
+	for _, condition := range tokenConditions(tokenClaims, err, time.Now()) {
+		r.setRegistrationCondition(&rhmPullSecret, registration, condition)
+	}
+
+	// the secret is reconciled again when the next expiry threshold is
+	// crossed
+	var requeueAfter time.Duration
+
+	if tokenClaims != nil && tokenClaims.ExpiresAt != 0 {
+		expiry := metav1.Unix(tokenClaims.ExpiresAt, 0)
+		registration.TokenExpiry = &expiry
+		pullSecretExpiry.Set(float64(tokenClaims.ExpiresAt))
+
+		var condition status.Condition
+		condition, requeueAfter = tokenExpiryCondition(expiry.Time, r.cfg.Marketplace.TokenExpiryThresholds, time.Now())
+		r.setRegistrationCondition(&rhmPullSecret, registration, condition)
+	}
+
 	if err != nil {
 		reqLogger.Error(err, "Token is missing account id")
+		r.updateRegistrationStatus(reqLogger, newMarketplaceConfig, registration)
+
 		annotations[utils.RHMPullSecretStatus] = "error"
 		annotations[utils.RHMPullSecretMessage] = "Account id is not available in provided token, please generate token from RH Marketplace again"
 		rhmPullSecret.SetAnnotations(annotations)
@@ -122,7 +194,19 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 	}
 
 	token := string(pullSecret)
-	r.mclientBuilder = marketplace.NewMarketplaceClientBuilder(r.cfg)
+
+	clusterID, err := r.clusterID(reqLogger, request.Namespace, newMarketplaceConfig)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	outbound := r.cfg.Outbound
+	if err := outbound.WithClusterProxy(context.TODO(), r.Client); err != nil {
+		reqLogger.Error(err, "failed to read cluster proxy")
+		return reconcile.Result{}, err
+	}
+
+	r.mclientBuilder = marketplace.NewMarketplaceClientBuilder(r.cfg).SetOutbound(&outbound)
 	mclient, err := r.mclientBuilder.NewMarketplaceClient(token, tokenClaims)
 
 	if err != nil {
@@ -130,17 +214,9 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 		return reconcile.Result{}, nil
 	}
 
-	newMarketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
-	err = r.Client.Get(context.TODO(), types.NamespacedName{
-		Namespace: request.Namespace,
-		Name:      "marketplaceconfig",
-	}, newMarketplaceConfig)
+	offline := r.cfg.Marketplace.OfflineRegistration
 
-	if err != nil && k8serrors.IsNotFound(err) {
-		newMarketplaceConfig = nil
-	}
-
-	if newMarketplaceConfig != nil {
+	if newMarketplaceConfig != nil && !offline {
 		reqLogger.Info("MarketPlace config object found, check status if its installed or not")
 		//Setting MarketplaceClientAccount
 
@@ -151,7 +227,14 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 
 		// Marketplace config object found
 		reqLogger.Info("Pulling MarketPlace config object status")
-		registrationStatusOutput, _ := mclient.RegistrationStatus(marketplaceClientAccount)
+		registrationStatusOutput, err := mclient.RegistrationStatus(marketplaceClientAccount)
+
+		if err == nil {
+			now := metav1.Now()
+			registration.LastContactTime = &now
+			r.setRegistrationCondition(&rhmPullSecret, registration,
+				*registrationStatusOutput.TransformConfigStatus().GetCondition(marketplacev1alpha1.ConditionRegistered))
+		}
 
 		if registrationStatusOutput.RegistrationStatus == marketplace.RegistrationStatusInstalled {
 			reqLogger.Info("MarketPlace config object is already registered for account")
@@ -172,11 +255,41 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 	}
 
 	reqLogger.Info("RHMarketPlace Pull Secret token found")
-	//Calling POST endpoint to pull the secret definition
-	newOptSecretObj, err := mclient.GetMarketplaceSecret()
+
+	var newOptSecretObj *v1.Secret
+	if offline {
+		newOptSecretObj, err = r.importOperatorSecret(reqLogger, request.Namespace, token, &marketplace.MarketplaceClientAccount{
+			AccountId:   tokenClaims.AccountID,
+			ClusterUuid: clusterID,
+		})
+
+		if err == nil && newOptSecretObj == nil {
+			r.setRegistrationCondition(&rhmPullSecret, registration, status.Condition{
+				Type:   marketplacev1alpha1.ConditionOperatorSecretFetched,
+				Status: v1.ConditionFalse,
+				Reason: marketplacev1alpha1.ReasonAwaitingResponse,
+				Message: fmt.Sprintf("export the registration request from configmap %s and import the response into secret %s",
+					marketplace.RegistrationRequestConfigMapName, marketplace.RegistrationResponseSecretName),
+			})
+			r.updateRegistrationStatus(reqLogger, newMarketplaceConfig, registration)
+			return reconcile.Result{}, nil
+		}
+	} else {
+		//Calling POST endpoint to pull the secret definition
+		newOptSecretObj, err = mclient.GetMarketplaceSecret()
+	}
+
 	if err != nil {
 		reqLogger.Info("RHMarketPlaceSecret failure")
 		reqLogger.Error(err, "RHMarketPlaceSecret failure")
+		r.setRegistrationCondition(&rhmPullSecret, registration, status.Condition{
+			Type:    marketplacev1alpha1.ConditionOperatorSecretFetched,
+			Status:  v1.ConditionFalse,
+			Reason:  marketplacev1alpha1.ReasonSecretFetchFailed,
+			Message: err.Error(),
+		})
+		r.updateRegistrationStatus(reqLogger, newMarketplaceConfig, registration)
+
 		annotations[utils.RHMPullSecretStatus] = "error"
 		annotations[utils.RHMPullSecretMessage] = err.Error()
 		rhmPullSecret.SetAnnotations(annotations)
@@ -187,6 +300,18 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 	}
 	newOptSecretObj.SetNamespace(request.Namespace)
 
+	if !offline {
+		now := metav1.Now()
+		registration.LastContactTime = &now
+	}
+
+	r.setRegistrationCondition(&rhmPullSecret, registration, status.Condition{
+		Type:    marketplacev1alpha1.ConditionOperatorSecretFetched,
+		Status:  v1.ConditionTrue,
+		Reason:  marketplacev1alpha1.ReasonSecretFetched,
+		Message: "rhm-operator-secret generated successfully",
+	})
+
 	//Fetch the Secret with name redhat-Operator-secret
 	secretKeyname := types.NamespacedName{
 		Name:      newOptSecretObj.Name,
@@ -238,29 +363,6 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 	}
 
 	//Create Markeplace Config object
-	reqLogger.Info("finding clusterversion resource")
-	clusterVersion := &openshiftconfigv1.ClusterVersion{}
-	err = r.Client.Get(context.Background(), client.ObjectKey{
-		Name: "version",
-	}, clusterVersion)
-
-	if err != nil {
-		if !k8serrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
-			reqLogger.Error(err, "Failed to retrieve clusterversion resource")
-			return reconcile.Result{}, err
-		}
-		clusterVersion = nil
-	}
-
-	var clusterID string
-	if clusterVersion != nil {
-		clusterID = string(clusterVersion.Spec.ClusterID)
-		reqLogger.Info("Clusterversion object found with clusterID", "clusterID", clusterID)
-	} else {
-		clusterID = uuid.New().String()
-		reqLogger.Info("Clusterversion object not found, generating clusterID", "clusterID", clusterID)
-	}
-
 	newMarketplaceConfig = &marketplacev1alpha1.MarketplaceConfig{}
 	err = r.Client.Get(context.TODO(), types.NamespacedName{
 		Namespace: request.Namespace,
@@ -287,6 +389,7 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 				return reconcile.Result{}, err
 			}
 
+			r.updateRegistrationStatus(reqLogger, newMarketplaceConfig, registration)
 			return reconcile.Result{Requeue: true}, nil
 		}
 
@@ -312,6 +415,8 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 		}
 	}
 
+	r.updateRegistrationStatus(reqLogger, newMarketplaceConfig, registration)
+
 	ownerFound := false
 	for _, owner := range rhmPullSecret.ObjectMeta.OwnerReferences {
 		if owner.Name == rhmPullSecret.Name &&
@@ -332,8 +437,274 @@ func (r *ClusterRegistrationReconciler) Reconcile(request reconcile.Request) (re
 		}
 	}
 
-	reqLogger.Info("reconcile finished. Marketplace Config Created")
-	return reconcile.Result{}, nil
+	reqLogger.Info("reconcile finished. Marketplace Config Created", "requeueAfter", requeueAfter)
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// clusterID returns the id of the OpenShift cluster version. Other clusters
+// keep the id they were registered with or the one of a pending offline
+// registration request, a new one is only generated for the first
+// registration.
+func (r *ClusterRegistrationReconciler) clusterID(
+	reqLogger logr.Logger,
+	namespace string,
+	marketplaceConfig *marketplacev1alpha1.MarketplaceConfig,
+) (string, error) {
+	reqLogger.Info("finding clusterversion resource")
+	clusterVersion := &openshiftconfigv1.ClusterVersion{}
+	err := r.Client.Get(context.Background(), client.ObjectKey{
+		Name: "version",
+	}, clusterVersion)
+
+	if err == nil {
+		clusterID := string(clusterVersion.Spec.ClusterID)
+		reqLogger.Info("Clusterversion object found with clusterID", "clusterID", clusterID)
+		return clusterID, nil
+	}
+
+	if !k8serrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		reqLogger.Error(err, "Failed to retrieve clusterversion resource")
+		return "", err
+	}
+
+	if marketplaceConfig != nil && marketplaceConfig.Spec.ClusterUUID != "" {
+		return marketplaceConfig.Spec.ClusterUUID, nil
+	}
+
+	registrationRequest := &v1.ConfigMap{}
+	err = r.Client.Get(context.TODO(), types.NamespacedName{
+		Name:      marketplace.RegistrationRequestConfigMapName,
+		Namespace: namespace,
+	}, registrationRequest)
+
+	if err != nil && !k8serrors.IsNotFound(err) {
+		reqLogger.Error(err, "failed to get registration request")
+		return "", err
+	}
+
+	if clusterID := registrationRequest.Data[marketplace.RegistrationClusterUUIDKey]; err == nil && clusterID != "" {
+		return clusterID, nil
+	}
+
+	clusterID := uuid.New().String()
+	reqLogger.Info("Clusterversion object not found, generating clusterID", "clusterID", clusterID)
+	return clusterID, nil
+}
+
+// importOperatorSecret returns the rhm-operator-secret imported from the
+// offline registration response. Until a response is imported the signed
+// registration request is kept in a config map to be exported and nil is
+// returned.
+func (r *ClusterRegistrationReconciler) importOperatorSecret(
+	reqLogger logr.Logger,
+	namespace string,
+	token string,
+	account *marketplace.MarketplaceClientAccount,
+) (*v1.Secret, error) {
+	registrationResponse := &v1.Secret{}
+	err := r.Client.Get(context.TODO(), types.NamespacedName{
+		Name:      marketplace.RegistrationResponseSecretName,
+		Namespace: namespace,
+	}, registrationResponse)
+
+	if err == nil {
+		reqLogger.Info("importing registration response")
+		return marketplace.ImportRegistrationResponse(token, string(registrationResponse.Data[marketplace.RegistrationResponseKey]), account)
+	}
+
+	if !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	request, err := marketplace.NewRegistrationRequest(token, account, version.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]string{
+		marketplace.RegistrationRequestKey:     request,
+		marketplace.RegistrationClusterUUIDKey: account.ClusterUuid,
+	}
+
+	registrationRequest := &v1.ConfigMap{}
+	err = r.Client.Get(context.TODO(), types.NamespacedName{
+		Name:      marketplace.RegistrationRequestConfigMapName,
+		Namespace: namespace,
+	}, registrationRequest)
+
+	if k8serrors.IsNotFound(err) {
+		reqLogger.Info("creating registration request")
+		registrationRequest.Name = marketplace.RegistrationRequestConfigMapName
+		registrationRequest.Namespace = namespace
+		registrationRequest.Data = data
+		return nil, r.Client.Create(context.TODO(), registrationRequest)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !reflect.DeepEqual(registrationRequest.Data, data) {
+		reqLogger.Info("updating registration request")
+		registrationRequest.Data = data
+		return nil, r.Client.Update(context.TODO(), registrationRequest)
+	}
+
+	return nil, nil
+}
+
+// tokenConditions returns the TokenValid and AccountResolved conditions of
+// the claims parsed from the pull secret token.
+func tokenConditions(claims *marketplace.MarketplaceClaims, err error, now time.Time) []status.Condition {
+	if err != nil || claims == nil {
+		message := "token can't be parsed, please generate token from RH Marketplace again"
+		if err != nil {
+			message = fmt.Sprintf("%s: %s", message, err.Error())
+		}
+
+		return []status.Condition{
+			{
+				Type:    marketplacev1alpha1.ConditionTokenValid,
+				Status:  v1.ConditionFalse,
+				Reason:  marketplacev1alpha1.ReasonTokenInvalid,
+				Message: message,
+			},
+			{
+				Type:    marketplacev1alpha1.ConditionAccountResolved,
+				Status:  v1.ConditionFalse,
+				Reason:  marketplacev1alpha1.ReasonAccountMissing,
+				Message: "Account id is not available in provided token, please generate token from RH Marketplace again",
+			},
+		}
+	}
+
+	tokenValid := status.Condition{
+		Type:    marketplacev1alpha1.ConditionTokenValid,
+		Status:  v1.ConditionTrue,
+		Reason:  marketplacev1alpha1.ReasonTokenParsed,
+		Message: "token is valid",
+	}
+
+	if claims.ExpiresAt != 0 && now.Unix() >= claims.ExpiresAt {
+		tokenValid = status.Condition{
+			Type:    marketplacev1alpha1.ConditionTokenValid,
+			Status:  v1.ConditionFalse,
+			Reason:  marketplacev1alpha1.ReasonTokenExpired,
+			Message: fmt.Sprintf("token expired at %s, please generate token from RH Marketplace again", time.Unix(claims.ExpiresAt, 0).UTC().Format(time.RFC3339)),
+		}
+	}
+
+	accountResolved := status.Condition{
+		Type:    marketplacev1alpha1.ConditionAccountResolved,
+		Status:  v1.ConditionTrue,
+		Reason:  marketplacev1alpha1.ReasonAccountFound,
+		Message: fmt.Sprintf("token is issued for account %s", claims.AccountID),
+	}
+
+	if claims.AccountID == "" {
+		accountResolved = status.Condition{
+			Type:    marketplacev1alpha1.ConditionAccountResolved,
+			Status:  v1.ConditionFalse,
+			Reason:  marketplacev1alpha1.ReasonAccountMissing,
+			Message: "Account id is not available in provided token, please generate token from RH Marketplace again",
+		}
+	}
+
+	return []status.Condition{tokenValid, accountResolved}
+}
+
+// tokenExpiryCondition returns the TokenExpiring condition for the smallest
+// threshold the expiry is within and how long until the next threshold or
+// the expiry is reached. Nothing is left to wait for once the token expired.
+func tokenExpiryCondition(expiry time.Time, thresholds []time.Duration, now time.Time) (status.Condition, time.Duration) {
+	remaining := expiry.Sub(now)
+	expiresAt := expiry.UTC().Format(time.RFC3339)
+
+	if remaining <= 0 {
+		return status.Condition{
+			Type:    marketplacev1alpha1.ConditionTokenExpiring,
+			Status:  v1.ConditionTrue,
+			Reason:  marketplacev1alpha1.ReasonTokenExpired,
+			Message: fmt.Sprintf("token expired at %s, please generate token from RH Marketplace again", expiresAt),
+		}, 0
+	}
+
+	// the next threshold is the largest one below the remaining time
+	next := remaining
+	for _, threshold := range thresholds {
+		if threshold < remaining && remaining-threshold < next {
+			next = remaining - threshold
+		}
+	}
+
+	sorted := append([]time.Duration{}, thresholds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	for _, threshold := range sorted {
+		if remaining <= threshold {
+			return status.Condition{
+				Type:    marketplacev1alpha1.ConditionTokenExpiring,
+				Status:  v1.ConditionTrue,
+				Reason:  marketplacev1alpha1.ReasonTokenExpiringSoon,
+				Message: fmt.Sprintf("token expires within %s at %s, please generate token from RH Marketplace again", formatThreshold(threshold), expiresAt),
+			}, next
+		}
+	}
+
+	return status.Condition{
+		Type:    marketplacev1alpha1.ConditionTokenExpiring,
+		Status:  v1.ConditionFalse,
+		Reason:  marketplacev1alpha1.ReasonTokenNotExpiring,
+		Message: fmt.Sprintf("token expires at %s", expiresAt),
+	}, next
+}
+
+// formatThreshold formats whole days as days, i.e. 7d.
+func formatThreshold(threshold time.Duration) string {
+	day := 24 * time.Hour
+	if threshold >= day && threshold%day == 0 {
+		return fmt.Sprintf("%dd", threshold/day)
+	}
+
+	return threshold.String()
+}
+
+// setRegistrationCondition sets the condition of the registration and
+// records an event on the pull secret when it changes.
+func (r *ClusterRegistrationReconciler) setRegistrationCondition(
+	secret *v1.Secret,
+	registration *marketplacev1alpha1.ClusterRegistrationStatus,
+	condition status.Condition,
+) {
+	if !registration.Conditions.SetCondition(condition) || r.recorder == nil {
+		return
+	}
+
+	eventType := v1.EventTypeNormal
+	if condition.Status != v1.ConditionTrue {
+		eventType = v1.EventTypeWarning
+	}
+
+	r.recorder.Event(secret, eventType, string(condition.Reason), condition.Message)
+}
+
+// updateRegistrationStatus writes the registration status to the
+// marketplace config if there is one. Failures are only logged, the
+// annotations of the pull secret still carry the outcome.
+func (r *ClusterRegistrationReconciler) updateRegistrationStatus(
+	reqLogger logr.Logger,
+	marketplaceConfig *marketplacev1alpha1.MarketplaceConfig,
+	registration *marketplacev1alpha1.ClusterRegistrationStatus,
+) {
+	if marketplaceConfig == nil || reflect.DeepEqual(marketplaceConfig.Status.Registration, registration) {
+		return
+	}
+
+	marketplaceConfig.Status.Registration = registration
+
+	if err := r.Client.Status().Update(context.TODO(), marketplaceConfig); err != nil {
+		reqLogger.Error(err, "failed to update registration status")
+	}
 }
 
 func (r *ClusterRegistrationReconciler) Inject(injector mktypes.Injectable) mktypes.SetupWithManager {
@@ -353,6 +724,8 @@ func (m *ClusterRegistrationReconciler) InjectMarketplaceClientBuilder(mbuilder
 
 func (r *ClusterRegistrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	namespacePredicate := predicates.NamespacePredicate(r.cfg.DeployedNamespace)
+	r.recorder = mgr.GetEventRecorderFor("clusterregistration-controller")
+
 	return ctrl.NewControllerManagedBy(mgr).
 		WithEventFilter(namespacePredicate).
 		For(&v1.Secret{}, builder.WithPredicates(
@@ -394,5 +767,19 @@ func (r *ClusterRegistrationReconciler) SetupWithManager(mgr ctrl.Manager) error
 				},
 			},
 		)).
+		Watches(
+			&source.Kind{Type: &v1.Secret{}},
+			&handler.EnqueueRequestsFromMapFunc{
+				ToRequests: handler.ToRequestsFunc(func(obj handler.MapObject) []reconcile.Request {
+					if obj.Meta.GetName() != marketplace.RegistrationResponseSecretName {
+						return nil
+					}
+
+					return []reconcile.Request{{NamespacedName: types.NamespacedName{
+						Name:      utils.RHMPullSecretName,
+						Namespace: obj.Meta.GetNamespace(),
+					}}}
+				}),
+			}).
 		Complete(r)
 }
@@ -0,0 +1,272 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package marketplace
+
+import (
+	"context"
+	"errors"
+	ioutil "io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/marketplace"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
+	status "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("ClusterRegistrationController", func() {
+	now := time.Now()
+
+	conditionsOf := func(conditions []status.Condition) status.Conditions {
+		result := status.Conditions{}
+		for _, condition := range conditions {
+			result.SetCondition(condition)
+		}
+		return result
+	}
+
+	It("should accept a token of an account", func() {
+		conditions := conditionsOf(tokenConditions(&marketplace.MarketplaceClaims{
+			AccountID:      "account",
+			StandardClaims: jwt.StandardClaims{ExpiresAt: now.Add(time.Hour).Unix()},
+		}, nil, now))
+
+		Expect(conditions.IsTrueFor(marketplacev1alpha1.ConditionTokenValid)).To(BeTrue())
+		Expect(conditions.IsTrueFor(marketplacev1alpha1.ConditionAccountResolved)).To(BeTrue())
+	})
+
+	It("should flag an expired token", func() {
+		conditions := conditionsOf(tokenConditions(&marketplace.MarketplaceClaims{
+			AccountID:      "account",
+			StandardClaims: jwt.StandardClaims{ExpiresAt: now.Add(-time.Hour).Unix()},
+		}, nil, now))
+
+		Expect(conditions.IsFalseFor(marketplacev1alpha1.ConditionTokenValid)).To(BeTrue())
+		Expect(conditions.GetCondition(marketplacev1alpha1.ConditionTokenValid).Reason).To(Equal(marketplacev1alpha1.ReasonTokenExpired))
+		Expect(conditions.IsTrueFor(marketplacev1alpha1.ConditionAccountResolved)).To(BeTrue())
+	})
+
+	It("should flag a token without account", func() {
+		conditions := conditionsOf(tokenConditions(&marketplace.MarketplaceClaims{}, nil, now))
+
+		Expect(conditions.IsTrueFor(marketplacev1alpha1.ConditionTokenValid)).To(BeTrue())
+		Expect(conditions.IsFalseFor(marketplacev1alpha1.ConditionAccountResolved)).To(BeTrue())
+	})
+
+	It("should flag a token that can't be parsed", func() {
+		conditions := conditionsOf(tokenConditions(nil, errors.New("malformed"), now))
+
+		Expect(conditions.GetCondition(marketplacev1alpha1.ConditionTokenValid).Reason).To(Equal(marketplacev1alpha1.ReasonTokenInvalid))
+		Expect(conditions.GetCondition(marketplacev1alpha1.ConditionTokenValid).Message).To(ContainSubstring("malformed"))
+		Expect(conditions.IsFalseFor(marketplacev1alpha1.ConditionAccountResolved)).To(BeTrue())
+	})
+
+	Describe("token expiry", func() {
+		thresholds := []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour}
+
+		It("should wait for the first threshold", func() {
+			condition, requeueAfter := tokenExpiryCondition(now.Add(60*24*time.Hour), thresholds, now)
+
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(marketplacev1alpha1.ReasonTokenNotExpiring))
+			Expect(requeueAfter).To(Equal(30 * 24 * time.Hour))
+		})
+
+		It("should warn within the smallest threshold crossed", func() {
+			condition, requeueAfter := tokenExpiryCondition(now.Add(5*24*time.Hour), thresholds, now)
+
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(marketplacev1alpha1.ReasonTokenExpiringSoon))
+			Expect(condition.Message).To(ContainSubstring("within 7d"))
+			Expect(requeueAfter).To(Equal(4 * 24 * time.Hour))
+
+			condition, requeueAfter = tokenExpiryCondition(now.Add(12*time.Hour), thresholds, now)
+			Expect(condition.Message).To(ContainSubstring("within 1d"))
+			Expect(requeueAfter).To(Equal(12 * time.Hour))
+		})
+
+		It("should stop requeuing once expired", func() {
+			condition, requeueAfter := tokenExpiryCondition(now.Add(-time.Minute), thresholds, now)
+
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(marketplacev1alpha1.ReasonTokenExpired))
+			Expect(requeueAfter).To(BeZero())
+		})
+
+		It("should requeue at expiry without thresholds", func() {
+			condition, requeueAfter := tokenExpiryCondition(now.Add(time.Hour), nil, now)
+
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(requeueAfter).To(Equal(time.Hour))
+		})
+	})
+
+	It("should record an event when a condition changes", func() {
+		recorder := record.NewFakeRecorder(10)
+		r := &ClusterRegistrationReconciler{recorder: recorder}
+		registration := &marketplacev1alpha1.ClusterRegistrationStatus{}
+		secret := &corev1.Secret{}
+
+		condition := status.Condition{
+			Type:    marketplacev1alpha1.ConditionOperatorSecretFetched,
+			Status:  corev1.ConditionFalse,
+			Reason:  marketplacev1alpha1.ReasonSecretFetchFailed,
+			Message: "unauthorized",
+		}
+
+		r.setRegistrationCondition(secret, registration, condition)
+		r.setRegistrationCondition(secret, registration, condition)
+
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(Equal("Warning SecretFetchFailed unauthorized"))
+		Expect(registration.Conditions.IsFalseFor(marketplacev1alpha1.ConditionOperatorSecretFetched)).To(BeTrue())
+	})
+
+	Describe("offline registration", func() {
+		const namespace = "openshift-redhat-marketplace"
+
+		var (
+			server *httptest.Server
+			token  string
+			r      *ClusterRegistrationReconciler
+		)
+
+		BeforeEach(func() {
+			var err error
+			token, err = jwt.NewWithClaims(jwt.SigningMethodHS256, &marketplace.MarketplaceClaims{
+				AccountID: "accountid",
+			}).SignedString([]byte("marketplace"))
+			Expect(err).To(Succeed())
+
+			body, err := ioutil.ReadFile("../../tests/mockresponses/marketplace-pull-secret.yaml")
+			Expect(err).To(Succeed())
+
+			// the fake marketplace is only reachable from the connected host
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				Expect(req.URL.Path).To(Equal("/" + marketplace.PullSecretEndpoint))
+				w.Write(body)
+			}))
+
+			s := runtime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(s)).To(Succeed())
+			Expect(marketplacev1alpha1.AddToScheme(s)).To(Succeed())
+			Expect(openshiftconfigv1.AddToScheme(s)).To(Succeed())
+
+			r = &ClusterRegistrationReconciler{
+				Client: fake.NewFakeClientWithScheme(s, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      utils.RHMPullSecretName,
+						Namespace: namespace,
+					},
+					Data: map[string][]byte{
+						utils.RHMPullSecretKey: []byte(token),
+					},
+				}),
+				Scheme: s,
+				Log:    logf.Log.WithName("clusterregistration"),
+				cfg: &config.OperatorConfig{
+					Marketplace: config.Marketplace{
+						URL:                 "https://marketplace.invalid",
+						OfflineRegistration: true,
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should register from an imported response", func() {
+			ctx := context.TODO()
+			request := reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      utils.RHMPullSecretName,
+				Namespace: namespace,
+			}}
+
+			_, err := r.Reconcile(request)
+			Expect(err).To(Succeed())
+
+			registrationRequest := &corev1.ConfigMap{}
+			Expect(r.Client.Get(ctx, types.NamespacedName{
+				Name:      marketplace.RegistrationRequestConfigMapName,
+				Namespace: namespace,
+			}, registrationRequest)).To(Succeed())
+
+			claims, err := marketplace.ParseRegistrationRequest(token, registrationRequest.Data[marketplace.RegistrationRequestKey])
+			Expect(err).To(Succeed())
+			Expect(claims.AccountID).To(Equal("accountid"))
+			Expect(claims.ClusterUUID).To(Equal(registrationRequest.Data[marketplace.RegistrationClusterUUIDKey]))
+
+			Expect(r.Client.Get(ctx, types.NamespacedName{
+				Name:      utils.RHMOperatorSecretName,
+				Namespace: namespace,
+			}, &corev1.Secret{})).To(MatchError(ContainSubstring("not found")))
+
+			// processed by an admin on a connected host
+			mclient, err := marketplace.NewMarketplaceClientBuilder(&config.OperatorConfig{
+				Marketplace: config.Marketplace{URL: server.URL},
+			}).NewMarketplaceClient(token, &marketplace.MarketplaceClaims{})
+			Expect(err).To(Succeed())
+
+			response, err := mclient.ProcessRegistrationRequest(token, registrationRequest.Data[marketplace.RegistrationRequestKey])
+			Expect(err).To(Succeed())
+
+			Expect(r.Client.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      marketplace.RegistrationResponseSecretName,
+					Namespace: namespace,
+				},
+				Data: map[string][]byte{
+					marketplace.RegistrationResponseKey: []byte(response),
+				},
+			})).To(Succeed())
+
+			_, err = r.Reconcile(request)
+			Expect(err).To(Succeed())
+			_, err = r.Reconcile(request)
+			Expect(err).To(Succeed())
+
+			Expect(r.Client.Get(ctx, types.NamespacedName{
+				Name:      utils.RHMOperatorSecretName,
+				Namespace: namespace,
+			}, &corev1.Secret{})).To(Succeed())
+
+			marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
+			Expect(r.Client.Get(ctx, types.NamespacedName{
+				Name:      "marketplaceconfig",
+				Namespace: namespace,
+			}, marketplaceConfig)).To(Succeed())
+			Expect(marketplaceConfig.Spec.ClusterUUID).To(Equal(claims.ClusterUUID))
+			Expect(marketplaceConfig.Status.Registration.Conditions.IsTrueFor(marketplacev1alpha1.ConditionOperatorSecretFetched)).To(BeTrue())
+		})
+	})
+})
@@ -236,53 +236,36 @@ func (r *ClusterServiceVersionReconciler) finalizeCSV(CSV *olmv1alpha1.ClusterSe
 
 }
 
-// deleteExternalResources searches for the MeterDefinition created by the CSV, if it's found delete it
+// deleteExternalResources deletes the MeterDefinitions installed by the CSV. They are found
+// by their InstalledBy reference, so they are removed even if the annotation changed.
 func (r *ClusterServiceVersionReconciler) deleteExternalResources(CSV *olmv1alpha1.ClusterServiceVersion) error {
 	reqLogger := r.Log.WithValues("Request.Name", CSV.GetName(), "Request.Namespace", CSV.GetNamespace())
 	reqLogger.Info("deleting csv")
 
-	annotations := CSV.GetAnnotations()
-	if annotations == nil {
-		reqLogger.Info("No annotations for this CSV")
-		return nil
-	}
-
-	meterDefinitionString, ok := annotations[utils.CSV_METERDEFINITION_ANNOTATION]
-	if !ok {
-		reqLogger.Info("No value for ", "key: ", utils.CSV_METERDEFINITION_ANNOTATION)
-		return nil
+	list := &marketplacev1beta1.MeterDefinitionList{}
+	if err := r.Client.List(context.TODO(), list, client.InNamespace(CSV.GetNamespace())); err != nil {
+		reqLogger.Error(err, "Could not retrieve the existing MeterDefinitions")
+		return err
 	}
 
-	var errAlpha, errBeta error
-	meterDefinitionBeta := &marketplacev1beta1.MeterDefinition{}
-	meterDefinitionAlpha := &marketplacev1alpha1.MeterDefinition{}
-
-	errBeta = meterDefinitionBeta.BuildMeterDefinitionFromString(meterDefinitionString, CSV.GetName(), CSV.GetNamespace(), utils.CSV_ANNOTATION_NAME, utils.CSV_ANNOTATION_NAMESPACE)
-
-	if errBeta != nil {
-		errAlpha = meterDefinitionAlpha.BuildMeterDefinitionFromString(meterDefinitionString, CSV.GetName(), CSV.GetNamespace(), utils.CSV_ANNOTATION_NAME, utils.CSV_ANNOTATION_NAMESPACE)
-	}
+	for i := range list.Items {
+		meterDef := &list.Items[i]
 
-	switch {
-	case errBeta == nil:
-		err := r.Client.Delete(context.TODO(), meterDefinitionBeta, client.PropagationPolicy(metav1.DeletePropagationForeground))
-		if err != nil && errors.IsNotFound(err) {
-			return err
+		if meterDef.Spec.InstalledBy == nil ||
+			meterDef.Spec.InstalledBy.Namespace != CSV.Namespace ||
+			meterDef.Spec.InstalledBy.Name != CSV.Name {
+			continue
 		}
-	case errAlpha == nil:
-		err := r.Client.Delete(context.TODO(), meterDefinitionAlpha, client.PropagationPolicy(metav1.DeletePropagationForeground))
-		if err != nil && errors.IsNotFound(err) {
+
+		err := r.Client.Delete(context.TODO(), meterDef, client.PropagationPolicy(metav1.DeletePropagationForeground))
+		if err != nil && !errors.IsNotFound(err) {
 			return err
 		}
-	default:
-		err := emperrors.Combine(errBeta, errAlpha)
-		reqLogger.Error(err, "Could not build a local copy of the MeterDefinition")
-		return err
+
+		reqLogger.Info("found and deleted MeterDefinition", "name", meterDef.Name)
 	}
 
-	reqLogger.Info("found and deleted MeterDefinition")
 	return nil
-
 }
 
 // reconcileMeterDefAnnotation checks the Annotations for the rhm CSV
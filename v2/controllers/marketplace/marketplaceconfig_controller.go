@@ -24,13 +24,17 @@ import (
 	"time"
 	"unicode/utf8"
 
+	merrors "emperror.dev/errors"
 	"github.com/go-logr/logr"
 	"github.com/gotidy/ptr"
+	openshiftoperatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
 	olmv1 "github.com/operator-framework/api/pkg/operators/v1"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
 	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/catalog"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/features"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/marketplace"
 	mktypes "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/types"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
@@ -41,6 +45,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -91,6 +96,13 @@ func (r *MarketplaceConfigReconciler) Reconcile(request reconcile.Request) (reco
 		r.mclientBuilder = marketplace.NewMarketplaceClientBuilder(r.cfg)
 	}
 
+	outbound := r.cfg.Outbound
+	if err := outbound.WithClusterProxy(context.TODO(), r.Client); err != nil {
+		reqLogger.Error(err, "failed to read cluster proxy")
+		return reconcile.Result{}, err
+	}
+	r.mclientBuilder.SetOutbound(&outbound)
+
 	// Fetch the MarketplaceConfig instance
 	marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
 	err := r.Client.Get(context.TODO(), request.NamespacedName, marketplaceConfig)
@@ -100,6 +112,12 @@ func (r *MarketplaceConfigReconciler) Reconcile(request reconcile.Request) (reco
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
 			reqLogger.Info("Resource not found. Ignoring since object must be deleted")
+
+			// the feature gates go back to their defaults without the MarketplaceConfig
+			if _, err := r.cfg.FeatureGate.Set(nil); err != nil {
+				reqLogger.Error(err, "Failed to reset the feature gates")
+			}
+
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
@@ -194,17 +212,23 @@ func (r *MarketplaceConfigReconciler) Reconcile(request reconcile.Request) (reco
 		return reconcile.Result{Requeue: true}, nil
 	}
 
+	decommissioning := marketplaceConfig.GetDeletionTimestamp() != nil || marketplaceConfig.Spec.Decommission
+
 	//Fetch the Secret with name redhat-marketplace-pull-secret
 	secret := v1.Secret{}
 	err = r.Client.Get(context.TODO(), types.NamespacedName{Name: utils.RHMPullSecretName, Namespace: request.Namespace}, &secret)
 	if err != nil {
-		if k8serrors.IsNotFound(err) {
-			reqLogger.Error(err, "error finding", "name", utils.RHMPullSecretName)
-			return reconcile.Result{}, nil
+		if !k8serrors.IsNotFound(err) {
+			reqLogger.Error(err, "error fetching secret")
+			return reconcile.Result{}, err
 		}
 
-		reqLogger.Error(err, "error fetching secret")
-		return reconcile.Result{}, err
+		reqLogger.Error(err, "error finding", "name", utils.RHMPullSecretName)
+
+		// the cluster is decommissioned without unregistering it
+		if !decommissioning {
+			return reconcile.Result{}, nil
+		}
 	}
 
 	pullSecret, tokenIsValid := secret.Data[utils.RHMPullSecretKey]
@@ -238,53 +262,63 @@ func (r *MarketplaceConfigReconciler) Reconcile(request reconcile.Request) (reco
 
 	}
 
+	var marketplaceClient *marketplace.MarketplaceClient
 	if tokenIsValid {
-		marketplaceClient, err := r.mclientBuilder.NewMarketplaceClient(token, tokenClaims)
+		marketplaceClient, err = r.mclientBuilder.NewMarketplaceClient(token, tokenClaims)
 
 		if err != nil {
 			reqLogger.Error(err, "error constructing marketplace client")
 			return reconcile.Result{Requeue: true}, nil
 		}
-
-		willBeDeleted := marketplaceConfig.GetDeletionTimestamp() != nil
-		if willBeDeleted {
-			result := r.unregister(marketplaceConfig, marketplaceClient, request, reqLogger)
-			if !result.Is(Continue) {
-				return result.Return()
-			}
-		}
 	}
 
 	newRazeeCrd := utils.BuildRazeeCr(marketplaceConfig.Namespace, marketplaceConfig.Spec.ClusterUUID, marketplaceConfig.Spec.DeploySecretName, marketplaceConfig.Spec.Features)
 	newMeterBaseCr := utils.BuildMeterBaseCr(marketplaceConfig.Namespace)
-	// Add finalizer and execute it if the resource is deleted
+
+	if decommissioning {
+		return r.decommission(marketplaceConfig, marketplaceClient, newRazeeCrd, newMeterBaseCr, reqLogger)
+	}
+
+	// Add finalizer so the cluster is decommissioned before it is deleted
 	if result, _ := cc.Do(
 		context.TODO(),
 		Call(SetFinalizer(marketplaceConfig, utils.CONTROLLER_FINALIZER)),
-		Call(
-			RunFinalizer(marketplaceConfig, utils.CONTROLLER_FINALIZER,
-				HandleResult(
-					GetAction(
-						types.NamespacedName{
-							Namespace: newRazeeCrd.Namespace, Name: newRazeeCrd.Name}, newRazeeCrd),
-					OnContinue(DeleteAction(newRazeeCrd))),
-				HandleResult(
-					GetAction(
-						types.NamespacedName{
-							Namespace: newMeterBaseCr.Namespace, Name: newMeterBaseCr.Name}, newMeterBaseCr),
-					OnContinue(DeleteAction(newMeterBaseCr))),
-			)),
 	); !result.Is(Continue) {
-
 		if result.Is(Error) {
-			reqLogger.Error(result.GetError(), "Failed to get MeterBase.")
+			reqLogger.Error(result.GetError(), "Failed to set finalizer.")
+		}
+
+		return result.Return()
+	}
+
+	// a cluster no longer decommissioned is installed again
+	if marketplaceConfig.Status.Decommission != nil {
+		marketplaceConfig.Status.Decommission = nil
+
+		err = r.Client.Status().Update(context.TODO(), marketplaceConfig)
+		if err != nil {
+			reqLogger.Error(err, "Failed to clear the decommission status")
+			return reconcile.Result{}, err
 		}
 
-		if result.Is(Return) {
-			reqLogger.Info("Delete is complete.")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	// the MarketplaceConfig sets the feature gates of the operator
+	if _, err := r.cfg.FeatureGate.Set(featureGateOverrides(marketplaceConfig.Spec.Features)); err != nil {
+		reqLogger.Error(err, "Failed to set the feature gates")
+	}
+
+	if featureGates := featureGateStatus(r.cfg.FeatureGate); !reflect.DeepEqual(marketplaceConfig.Status.FeatureGates, featureGates) {
+		marketplaceConfig.Status.FeatureGates = featureGates
+
+		err = r.Client.Status().Update(context.TODO(), marketplaceConfig)
+		if err != nil {
+			reqLogger.Error(err, "Failed to update the feature gates status")
+			return reconcile.Result{}, err
 		}
 
-		return result.Return()
+		return reconcile.Result{Requeue: true}, nil
 	}
 
 	if marketplaceConfig.Labels == nil {
@@ -415,7 +449,7 @@ func (r *MarketplaceConfigReconciler) Reconcile(request reconcile.Request) (reco
 
 	reqLogger.Info("meterbase is enabled")
 	// Check if MeterBase exists, if not create one
-	if result.Is(NotFound) {
+	if result.Is(NotFound) && r.cfg.FeatureGate.Enabled(features.Metering) {
 		newMeterBaseCr := utils.BuildMeterBaseCr(marketplaceConfig.Namespace)
 
 		if err = controllerutil.SetControllerReference(marketplaceConfig, newMeterBaseCr, r.Scheme); err != nil {
@@ -500,11 +534,11 @@ func (r *MarketplaceConfigReconciler) Reconcile(request reconcile.Request) (reco
 
 	reqLogger.Info("Found opsource")
 
-	for _, catalogSrcName := range [2]string{utils.IBM_CATALOGSRC_NAME, utils.OPENCLOUD_CATALOGSRC_NAME} {
-		requeueFlag, err := r.createCatalogSource(request, marketplaceConfig, catalogSrcName)
-		if requeueFlag && err == nil {
-			return reconcile.Result{Requeue: true}, nil
-		}
+	requeueFlag, err := r.reconcileCatalogSources(request, marketplaceConfig)
+	if err != nil {
+		reqLogger.Error(err, "failed to reconcile catalog sources")
+	} else if requeueFlag {
+		return reconcile.Result{Requeue: true}, nil
 	}
 
 	var updated bool
@@ -600,49 +634,43 @@ func labelsForMarketplaceConfig(name string) map[string]string {
 	return map[string]string{"app": "marketplaceconfig", "marketplaceconfig_cr": name}
 }
 
-// Begin installation or deletion of Catalog Source
-func (r *MarketplaceConfigReconciler) createCatalogSource(request reconcile.Request, marketplaceConfig *marketplacev1alpha1.MarketplaceConfig, catalogName string) (bool, error) {
-	reqLogger := r.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name, "CatalogSource.Name", catalogName)
+// reconcileCatalogSources installs the catalog sources of the MarketplaceConfig,
+// updates their settings and removes the ones it installed that are no longer
+// listed. Returns true after a catalog source is installed or removed.
+func (r *MarketplaceConfigReconciler) reconcileCatalogSources(request reconcile.Request, marketplaceConfig *marketplacev1alpha1.MarketplaceConfig) (bool, error) {
+	reqLogger := r.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 
 	// Get installation setting for Catalog Source (checks MarketplaceConfig.Spec if it doesn't exist, use flag)
-	installCatalogSrcP := marketplaceConfig.Spec.InstallIBMCatalogSource
-	var installCatalogSrc bool
-
-	if installCatalogSrcP == nil {
-
+	if marketplaceConfig.Spec.InstallIBMCatalogSource == nil {
 		reqLogger.Info("MarketplaceConfig.Spec.InstallIBMCatalogSource not found. Using flag.")
-		installCatalogSrc = r.cfg.Features.IBMCatalog
+		installCatalogSrc := r.cfg.FeatureGate.Enabled(features.IBMCatalogSource)
 
 		marketplaceConfig.Spec.InstallIBMCatalogSource = &installCatalogSrc
 		r.Client.Update(context.TODO(), marketplaceConfig)
 		return true, nil
-	} else {
-		reqLogger.Info("MarketplaceConfig.Spec.InstallIBMCatalogSource found")
-		installCatalogSrc = *installCatalogSrcP
 	}
 
-	// Check if the Catalog Source exists.
-	catalogSrc := &operatorsv1alpha1.CatalogSource{}
-	catalogSrcNamespacedName := types.NamespacedName{
-		Name:      catalogName,
-		Namespace: utils.OPERATOR_MKTPLACE_NS}
-	err := r.Client.Get(context.TODO(), catalogSrcNamespacedName, catalogSrc)
+	policies, err := r.imageContentSourcePolicies()
+	if err != nil {
+		return false, err
+	}
+
+	desired := map[string]bool{}
+	for _, config := range catalog.Configs(marketplaceConfig) {
+		desired[config.Name] = true
+
+		newCatalogSrc, err := catalog.Build(config, policies)
+		if err != nil {
+			reqLogger.Error(err, "Invalid catalog source", "CatalogSource.Name", config.Name)
+			continue
+		}
+
+		catalogSrc := &operatorsv1alpha1.CatalogSource{}
+		err = r.Client.Get(context.TODO(), types.NamespacedName{Name: newCatalogSrc.Name, Namespace: newCatalogSrc.Namespace}, catalogSrc)
 
-	// If installCatalogSrc is true: install Catalog Source
-	// if installCatalogSrc is false: do not install Catalog Source, and delete existing one (if it exists)
-	reqLogger.Info("Checking Install Catalog Src", "InstallCatalogSource: ", installCatalogSrc)
-	if installCatalogSrc {
 		// If the Catalog Source does not exist, create one
 		if err != nil && k8serrors.IsNotFound(err) {
-			// Create catalog source
-			var newCatalogSrc *operatorsv1alpha1.CatalogSource
-			if utils.IBM_CATALOGSRC_NAME == catalogName {
-				newCatalogSrc = utils.BuildNewIBMCatalogSrc()
-			} else { // utils.OPENCLOUD_CATALOGSRC_NAME
-				newCatalogSrc = utils.BuildNewOpencloudCatalogSrc()
-			}
-
-			reqLogger.Info("Creating catalog source")
+			reqLogger.Info("Creating catalog source", "CatalogSource.Name", newCatalogSrc.Name, "Image", newCatalogSrc.Spec.Image)
 			err = r.Client.Create(context.TODO(), newCatalogSrc)
 			if err != nil {
 				reqLogger.Info("Failed to create a CatalogSource.", "CatalogSource.Namespace ", newCatalogSrc.Namespace, "CatalogSource.Name", newCatalogSrc.Name)
@@ -653,12 +681,11 @@ func (r *MarketplaceConfigReconciler) createCatalogSource(request reconcile.Requ
 				Type:    marketplacev1alpha1.ConditionInstalling,
 				Status:  corev1.ConditionTrue,
 				Reason:  marketplacev1alpha1.ReasonCatalogSourceInstall,
-				Message: catalogName + " catalog source installed.",
+				Message: newCatalogSrc.Name + " catalog source installed.",
 			})
 
 			if ok {
 				err = r.Client.Status().Update(context.TODO(), marketplaceConfig)
-
 				if err != nil {
 					reqLogger.Error(err, "failed to update status")
 					return false, err
@@ -669,55 +696,80 @@ func (r *MarketplaceConfigReconciler) createCatalogSource(request reconcile.Requ
 			return true, nil
 		} else if err != nil {
 			// Could not get catalog source
-			reqLogger.Error(err, "Failed to get CatalogSource", "CatalogSource.Namespace ", catalogSrcNamespacedName.Namespace, "CatalogSource.Name", catalogSrcNamespacedName.Name)
+			reqLogger.Error(err, "Failed to get CatalogSource", "CatalogSource.Namespace ", newCatalogSrc.Namespace, "CatalogSource.Name", newCatalogSrc.Name)
 			return false, err
 		}
 
-		reqLogger.Info("Found CatalogSource", "CatalogSource.Namespace ", catalogSrcNamespacedName.Namespace, "CatalogSource.Name", catalogSrcNamespacedName.Name)
-
-	} else {
-		// If catalog source exists, delete it.
-		if err == nil {
-			// Delete catalog source.
-			reqLogger.Info("Deleting catalog source")
-			catalogSrc.Name = catalogSrcNamespacedName.Name
-			catalogSrc.Namespace = catalogSrcNamespacedName.Namespace
-			err = r.Client.Delete(context.TODO(), catalogSrc, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if catalog.Update(catalogSrc, newCatalogSrc) {
+			reqLogger.Info("Updating catalog source", "CatalogSource.Name", catalogSrc.Name, "Image", catalogSrc.Spec.Image)
+			err = r.Client.Update(context.TODO(), catalogSrc)
 			if err != nil {
-				reqLogger.Info("Failed to delete the existing CatalogSource.", "CatalogSource.Namespace ", catalogSrc.Namespace, "CatalogSource.Name", catalogSrc.Name)
+				reqLogger.Error(err, "Failed to update CatalogSource", "CatalogSource.Namespace ", catalogSrc.Namespace, "CatalogSource.Name", catalogSrc.Name)
 				return false, err
 			}
+		}
+	}
 
-			ok := marketplaceConfig.Status.Conditions.SetCondition(status.Condition{
-				Type:    marketplacev1alpha1.ConditionInstalling,
-				Status:  corev1.ConditionTrue,
-				Reason:  marketplacev1alpha1.ReasonCatalogSourceDelete,
-				Message: catalogName + " catalog source deleted.",
-			})
+	catalogSrcList := &operatorsv1alpha1.CatalogSourceList{}
+	err = r.Client.List(context.TODO(), catalogSrcList, client.InNamespace(utils.OPERATOR_MKTPLACE_NS))
+	if err != nil {
+		reqLogger.Error(err, "Failed to list CatalogSources")
+		return false, err
+	}
 
-			if ok {
-				err = r.Client.Status().Update(context.TODO(), marketplaceConfig)
-				if err != nil {
-					reqLogger.Error(err, "failed to update status")
-					return false, err
-				}
-			}
+	for i := range catalogSrcList.Items {
+		catalogSrc := &catalogSrcList.Items[i]
+		if desired[catalogSrc.Name] || !catalog.IsManaged(catalogSrc) {
+			continue
+		}
 
-			// catalog source deleted successfully - return and requeue
-			return true, nil
-		} else if err != nil && !k8serrors.IsNotFound(err) {
-			// Could not get catalog source
-			reqLogger.Error(err, "Failed to get CatalogSource", "CatalogSource.Namespace ", catalogSrcNamespacedName.Namespace, "CatalogSource.Name", catalogSrcNamespacedName.Name)
+		// Delete catalog sources no longer listed.
+		reqLogger.Info("Deleting catalog source", "CatalogSource.Name", catalogSrc.Name)
+		err = r.Client.Delete(context.TODO(), catalogSrc, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !k8serrors.IsNotFound(err) {
+			reqLogger.Info("Failed to delete the existing CatalogSource.", "CatalogSource.Namespace ", catalogSrc.Namespace, "CatalogSource.Name", catalogSrc.Name)
 			return false, err
 		}
 
-		reqLogger.Info(catalogName + " catalog Source does not exist.")
+		ok := marketplaceConfig.Status.Conditions.SetCondition(status.Condition{
+			Type:    marketplacev1alpha1.ConditionInstalling,
+			Status:  corev1.ConditionTrue,
+			Reason:  marketplacev1alpha1.ReasonCatalogSourceDelete,
+			Message: catalogSrc.Name + " catalog source deleted.",
+		})
+
+		if ok {
+			err = r.Client.Status().Update(context.TODO(), marketplaceConfig)
+			if err != nil {
+				reqLogger.Error(err, "failed to update status")
+				return false, err
+			}
+		}
 
+		// catalog source deleted successfully - return and requeue
+		return true, nil
 	}
+
 	return false, nil
 }
 
-func (r *MarketplaceConfigReconciler) unregister(marketplaceConfig *marketplacev1alpha1.MarketplaceConfig, marketplaceClient *marketplace.MarketplaceClient, request reconcile.Request, reqLogger logr.Logger) *ExecResult {
+// imageContentSourcePolicies returns the ImageContentSourcePolicies of the
+// cluster, none on clusters without them.
+func (r *MarketplaceConfigReconciler) imageContentSourcePolicies() ([]openshiftoperatorv1alpha1.ImageContentSourcePolicy, error) {
+	policyList := &openshiftoperatorv1alpha1.ImageContentSourcePolicyList{}
+	err := r.Client.List(context.TODO(), policyList)
+	if meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, merrors.Wrap(err, "failed to list image content source policies")
+	}
+
+	return policyList.Items, nil
+}
+
+func (r *MarketplaceConfigReconciler) unregister(marketplaceConfig *marketplacev1alpha1.MarketplaceConfig, marketplaceClient *marketplace.MarketplaceClient, reqLogger logr.Logger) error {
 	reqLogger.Info("attempting to un-register")
 
 	marketplaceClientAccount := &marketplace.MarketplaceClientAccount{
@@ -730,17 +782,280 @@ func (r *MarketplaceConfigReconciler) unregister(marketplaceConfig *marketplacev
 	registrationStatusOutput, err := marketplaceClient.UnRegister(marketplaceClientAccount)
 	if err != nil {
 		reqLogger.Error(err, "unregister failed")
-		return &ExecResult{
-			ReconcileResult: reconcile.Result{Requeue: true},
-			Err:             nil,
-		}
+		return err
 	}
 
 	reqLogger.Info("unregister", "RegistrationStatus", registrationStatusOutput.RegistrationStatus)
+	return nil
+}
+
+// featureGateOverrides are the feature gates set in the MarketplaceConfig.
+func featureGateOverrides(spec *common.Features) map[features.Feature]bool {
+	overrides := map[features.Feature]bool{}
+
+	if spec == nil {
+		return overrides
+	}
+
+	for name, enabled := range spec.Gates {
+		overrides[features.Feature(name)] = enabled
+	}
+
+	if spec.Deployment != nil {
+		overrides[features.Deployment] = *spec.Deployment
+	}
 
-	return &ExecResult{
-		Status: ActionResultStatus(Continue),
+	if spec.Registration != nil {
+		overrides[features.Registration] = *spec.Registration
 	}
+
+	return overrides
+}
+
+func featureGateStatus(gate *features.FeatureGate) []marketplacev1alpha1.FeatureGateStatus {
+	featureGates := []marketplacev1alpha1.FeatureGateStatus{}
+
+	for _, feature := range gate.Features() {
+		spec, _ := gate.Spec(feature)
+		featureGates = append(featureGates, marketplacev1alpha1.FeatureGateStatus{
+			Name:     string(feature),
+			Maturity: string(spec.Maturity),
+			Enabled:  gate.Enabled(feature),
+		})
+	}
+
+	return featureGates
+}
+
+// nextDecommissionPhase is the phase that follows a completed phase.
+var nextDecommissionPhase = map[marketplacev1alpha1.DecommissionPhase]marketplacev1alpha1.DecommissionPhase{
+	marketplacev1alpha1.DecommissionPhaseFinalReport:     marketplacev1alpha1.DecommissionPhaseUnregister,
+	marketplacev1alpha1.DecommissionPhaseUnregister:      marketplacev1alpha1.DecommissionPhaseRemoveRazee,
+	marketplacev1alpha1.DecommissionPhaseRemoveRazee:     marketplacev1alpha1.DecommissionPhaseRemoveMeterBase,
+	marketplacev1alpha1.DecommissionPhaseRemoveMeterBase: marketplacev1alpha1.DecommissionPhaseComplete,
+}
+
+// decommission runs one phase of decommissioning the cluster per reconcile:
+// report the usage metered since the last report, unregister the cluster,
+// then remove razee and the meterbase. Once complete the finalizer is
+// removed from a deleted MarketplaceConfig.
+func (r *MarketplaceConfigReconciler) decommission(
+	marketplaceConfig *marketplacev1alpha1.MarketplaceConfig,
+	marketplaceClient *marketplace.MarketplaceClient,
+	razee *marketplacev1alpha1.RazeeDeployment,
+	meterBase *marketplacev1alpha1.MeterBase,
+	reqLogger logr.Logger,
+) (reconcile.Result, error) {
+	if marketplaceConfig.Status.Decommission == nil {
+		reqLogger.Info("decommissioning cluster")
+		marketplaceConfig.Status.Decommission = &marketplacev1alpha1.DecommissionStatus{
+			Phase:     marketplacev1alpha1.DecommissionPhaseFinalReport,
+			Message:   "Reporting the usage metered since the last report",
+			StartTime: metav1.Now(),
+		}
+
+		if err := r.Client.Status().Update(context.TODO(), marketplaceConfig); err != nil {
+			reqLogger.Error(err, "Failed to update the decommission status")
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	decommission := marketplaceConfig.Status.Decommission
+	reqLogger = reqLogger.WithValues("phase", decommission.Phase)
+
+	var (
+		done    bool
+		message string
+		err     error
+	)
+
+	switch decommission.Phase {
+	case marketplacev1alpha1.DecommissionPhaseFinalReport:
+		done, message, err = r.decommissionFinalReport(marketplaceConfig, meterBase)
+	case marketplacev1alpha1.DecommissionPhaseUnregister:
+		done, message, err = r.decommissionUnregister(marketplaceConfig, marketplaceClient, reqLogger)
+	case marketplacev1alpha1.DecommissionPhaseRemoveRazee:
+		done, message, err = r.decommissionRemove(razee, "RazeeDeployment")
+	case marketplacev1alpha1.DecommissionPhaseRemoveMeterBase:
+		done, message, err = r.decommissionRemove(meterBase, "MeterBase")
+	default:
+		return r.decommissionComplete(marketplaceConfig, reqLogger)
+	}
+
+	if err != nil {
+		reqLogger.Error(err, "decommission phase failed")
+		message = err.Error()
+	}
+
+	if done {
+		reqLogger.Info("decommission phase complete", "message", message)
+		decommission.Phase = nextDecommissionPhase[decommission.Phase]
+
+		if decommission.Phase == marketplacev1alpha1.DecommissionPhaseComplete {
+			now := metav1.Now()
+			decommission.CompletionTime = &now
+		}
+	}
+
+	if message != decommission.Message || done {
+		decommission.Message = message
+
+		if err := r.Client.Status().Update(context.TODO(), marketplaceConfig); err != nil {
+			reqLogger.Error(err, "Failed to update the decommission status")
+			return reconcile.Result{}, err
+		}
+	}
+
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if done {
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+}
+
+// decommissionFinalReport creates a MeterReport from the end of the last
+// report to the start of the decommission and waits for it to upload. The
+// cluster is unregistered without it once the decommission timeout passes.
+func (r *MarketplaceConfigReconciler) decommissionFinalReport(
+	marketplaceConfig *marketplacev1alpha1.MarketplaceConfig,
+	meterBase *marketplacev1alpha1.MeterBase,
+) (bool, string, error) {
+	decommission := marketplaceConfig.Status.Decommission
+
+	if decommission.FinalReport == "" {
+		if !r.cfg.FeatureGate.Enabled(features.Reporting) {
+			return true, "Skipped the final report, reporting is disabled", nil
+		}
+
+		err := r.Client.Get(context.TODO(), types.NamespacedName{Name: meterBase.Name, Namespace: meterBase.Namespace}, meterBase)
+		if k8serrors.IsNotFound(err) {
+			return true, "Skipped the final report, metering is not installed", nil
+		}
+		if err != nil {
+			return false, "", err
+		}
+
+		reports := &marketplacev1alpha1.MeterReportList{}
+		err = r.Client.List(context.TODO(), reports, client.InNamespace(marketplaceConfig.Namespace))
+		if err != nil {
+			return false, "", err
+		}
+
+		// reports ending after the decommission started will never run
+		startTime := meterBase.CreationTimestamp.Time
+		for _, report := range reports.Items {
+			endTime := report.Spec.EndTime.Time
+			if endTime.After(startTime) && !endTime.After(decommission.StartTime.Time) {
+				startTime = endTime
+			}
+		}
+
+		if !startTime.Before(decommission.StartTime.Time) {
+			return true, "Skipped the final report, all usage is reported", nil
+		}
+
+		name := fmt.Sprintf("%sfinal-%d", utils.METER_REPORT_PREFIX, decommission.StartTime.Unix())
+		report := (&MeterBaseReconciler{}).newMeterReport(marketplaceConfig.Namespace, startTime, decommission.StartTime.Time, name, meterBase, promServiceName)
+
+		err = r.Client.Create(context.TODO(), report)
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return false, "", err
+		}
+
+		decommission.FinalReport = name
+		return false, fmt.Sprintf("Waiting for the final report %s to upload", name), nil
+	}
+
+	report := &marketplacev1alpha1.MeterReport{}
+	err := r.Client.Get(context.TODO(), types.NamespacedName{Name: decommission.FinalReport, Namespace: marketplaceConfig.Namespace}, report)
+	if k8serrors.IsNotFound(err) {
+		decommission.FinalReport = ""
+		return false, "Recreating the final report", nil
+	}
+	if err != nil {
+		return false, "", err
+	}
+
+	switch {
+	case report.Status.UploadID != nil:
+		return true, fmt.Sprintf("Final report %s uploaded as %s", report.Name, *report.Status.UploadID), nil
+	case report.Status.AssociatedJob != nil && report.Status.AssociatedJob.IsSuccessful():
+		return true, fmt.Sprintf("Final report %s finished without an upload", report.Name), nil
+	case time.Since(decommission.StartTime.Time) > r.cfg.ReportController.DecommissionTimeout:
+		return true, fmt.Sprintf("Final report %s was not uploaded within %s", report.Name, r.cfg.ReportController.DecommissionTimeout), nil
+	}
+
+	return false, fmt.Sprintf("Waiting for the final report %s to upload", report.Name), nil
+}
+
+func (r *MarketplaceConfigReconciler) decommissionUnregister(
+	marketplaceConfig *marketplacev1alpha1.MarketplaceConfig,
+	marketplaceClient *marketplace.MarketplaceClient,
+	reqLogger logr.Logger,
+) (bool, string, error) {
+	if r.cfg.Marketplace.OfflineRegistration {
+		return true, "Skipped unregistering, the cluster is registered offline", nil
+	}
+
+	if marketplaceClient == nil {
+		return true, "Skipped unregistering, the pull secret has no valid token", nil
+	}
+
+	if err := r.unregister(marketplaceConfig, marketplaceClient, reqLogger); err != nil {
+		return false, "", err
+	}
+
+	return true, "Cluster unregistered", nil
+}
+
+// decommissionRemove deletes the object and waits until it is gone, its own
+// finalizers clean up what it installed.
+func (r *MarketplaceConfigReconciler) decommissionRemove(obj runtime.Object, kind string) (bool, string, error) {
+	key, err := client.ObjectKeyFromObject(obj)
+	if err != nil {
+		return false, "", err
+	}
+
+	err = r.Client.Get(context.TODO(), key, obj)
+	if k8serrors.IsNotFound(err) {
+		return true, fmt.Sprintf("%s removed", kind), nil
+	}
+	if err != nil {
+		return false, "", err
+	}
+
+	err = r.Client.Delete(context.TODO(), obj)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return false, "", err
+	}
+
+	return false, fmt.Sprintf("Waiting for the %s to be removed", kind), nil
+}
+
+func (r *MarketplaceConfigReconciler) decommissionComplete(
+	marketplaceConfig *marketplacev1alpha1.MarketplaceConfig,
+	reqLogger logr.Logger,
+) (reconcile.Result, error) {
+	if marketplaceConfig.GetDeletionTimestamp() == nil ||
+		!utils.Contains(marketplaceConfig.GetFinalizers(), utils.CONTROLLER_FINALIZER) {
+		return reconcile.Result{}, nil
+	}
+
+	marketplaceConfig.SetFinalizers(utils.RemoveKey(marketplaceConfig.GetFinalizers(), utils.CONTROLLER_FINALIZER))
+
+	if err := r.Client.Update(context.TODO(), marketplaceConfig); err != nil {
+		reqLogger.Error(err, "Failed to remove the finalizer")
+		return reconcile.Result{}, err
+	}
+
+	reqLogger.Info("Delete is complete.")
+	return reconcile.Result{}, nil
 }
 
 func (r *MarketplaceConfigReconciler) Inject(injector mktypes.Injectable) mktypes.SetupWithManager {
@@ -773,6 +1088,10 @@ func (r *MarketplaceConfigReconciler) SetupWithManager(mgr manager.Manager) erro
 
 	namespacePredicate := predicates.NamespacePredicate(r.cfg.DeployedNamespace)
 
+	if err := r.loadFeatureGates(mgr.GetAPIReader()); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&marketplacev1alpha1.MarketplaceConfig{}).
 		WithEventFilter(namespacePredicate).
@@ -789,6 +1108,31 @@ func (r *MarketplaceConfigReconciler) SetupWithManager(mgr manager.Manager) erro
 		Complete(r)
 }
 
+// loadFeatureGates sets the feature gates from the MarketplaceConfig before
+// the manager starts, so no controller runs on the defaults after a restart.
+// The reader is not cached as the cache isn't started yet.
+func (r *MarketplaceConfigReconciler) loadFeatureGates(reader client.Reader) error {
+	marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
+	err := reader.Get(context.TODO(), types.NamespacedName{
+		Name:      utils.MARKETPLACECONFIG_NAME,
+		Namespace: r.cfg.DeployedNamespace,
+	}, marketplaceConfig)
+
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return merrors.Wrap(err, "failed to get marketplaceconfig for the feature gates")
+	}
+
+	if _, err := r.cfg.FeatureGate.Set(featureGateOverrides(marketplaceConfig.Spec.Features)); err != nil {
+		r.Log.Error(err, "Failed to set the feature gates")
+	}
+
+	return nil
+}
+
 // getOperatorGroup returns the associated OLM OperatorGroup
 func getOperatorGroup() (string, error) {
 	// OperatorGroupEnvVar is the constant for env variable OPERATOR_GROUP
@@ -15,8 +15,11 @@
 package marketplace
 
 import (
+	"context"
 	"crypto/tls"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
@@ -24,12 +27,15 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
+	openshiftoperatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
 
 	opsrcApi "github.com/operator-framework/api/pkg/operators/v1"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
 	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/catalog"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	featuregate "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/features"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/marketplace"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/reconcileutils"
@@ -38,6 +44,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -126,6 +133,7 @@ var _ = Describe("Testing with Ginkgo", func() {
 				URL:            addr,
 				InsecureClient: true,
 			},
+			FeatureGate: featuregate.NewFeatureGate(),
 		}
 
 		mbuilder = marketplace.NewMarketplaceClientBuilder(cfg).SetTLSConfig(&tls.Config{
@@ -215,3 +223,366 @@ var _ = Describe("Testing with Ginkgo", func() {
 
 	})
 })
+
+var _ = Describe("MarketplaceConfig decommission", func() {
+	const namespace = "openshift-redhat-marketplace"
+
+	var (
+		server       *httptest.Server
+		unregistered bool
+		r            *MarketplaceConfigReconciler
+		lastReportAt time.Time
+		req          = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      utils.MARKETPLACECONFIG_NAME,
+				Namespace: namespace,
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		unregistered = false
+		body, err := ioutil.ReadFile("../../tests/mockresponses/registration-response.json")
+		Expect(err).To(Succeed())
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch {
+			case req.Method == "GET" && req.URL.Path == "/"+marketplace.RegistrationEndpoint:
+				w.Write(body)
+			case req.Method == "PATCH" && req.URL.Path == "/"+marketplace.RegistrationEndpoint+"/test":
+				unregistered = true
+				w.Write([]byte("[]"))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		token, err := jwt.New(jwt.SigningMethodHS256).SignedString([]byte("test"))
+		Expect(err).To(Succeed())
+
+		s := runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(marketplacev1alpha1.AddToScheme(s)).To(Succeed())
+
+		marketplaceconfig := utils.BuildMarketplaceConfigCR(namespace, "accountid")
+		marketplaceconfig.Spec.ClusterUUID = "test"
+		marketplaceconfig.Spec.Decommission = true
+		marketplaceconfig.Spec.NamespaceLabelSelector = &metav1.LabelSelector{}
+		marketplaceconfig.Finalizers = []string{utils.CONTROLLER_FINALIZER}
+
+		meterbase := utils.BuildMeterBaseCr(namespace)
+		meterbase.CreationTimestamp = metav1.NewTime(time.Now().Add(-48 * time.Hour))
+
+		lastReportAt = time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+
+		r = &MarketplaceConfigReconciler{
+			Client: fake.NewFakeClientWithScheme(s,
+				marketplaceconfig,
+				meterbase,
+				utils.BuildRazeeCr(namespace, "test", nil, nil),
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   namespace,
+						Labels: map[string]string{utils.LicenseServerTag: "true"},
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      utils.RHMPullSecretName,
+						Namespace: namespace,
+					},
+					Data: map[string][]byte{
+						utils.RHMPullSecretKey: []byte(token),
+					},
+				},
+				&marketplacev1alpha1.MeterReport{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      utils.METER_REPORT_PREFIX + "yesterday",
+						Namespace: namespace,
+					},
+					Spec: marketplacev1alpha1.MeterReportSpec{
+						StartTime: metav1.NewTime(lastReportAt.Add(-24 * time.Hour)),
+						EndTime:   metav1.NewTime(lastReportAt),
+					},
+				},
+			),
+			Scheme: s,
+			Log:    logf.Log.WithName("marketplaceconfig"),
+			cfg: &config.OperatorConfig{
+				DeployedNamespace: namespace,
+				Marketplace: config.Marketplace{
+					URL: server.URL,
+				},
+				ReportController: config.ReportControllerConfig{
+					DecommissionTimeout: 2 * time.Hour,
+				},
+				FeatureGate: featuregate.NewFeatureGate(),
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	getMarketplaceConfig := func() *marketplacev1alpha1.MarketplaceConfig {
+		marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
+		Expect(r.Client.Get(context.TODO(), req.NamespacedName, marketplaceConfig)).To(Succeed())
+		return marketplaceConfig
+	}
+
+	reconcileTimes := func(times int) {
+		for i := 0; i < times; i++ {
+			_, err := r.Reconcile(req)
+			Expect(err).To(Succeed())
+		}
+	}
+
+	It("should report, unregister and remove the components in order", func() {
+		reconcileTimes(5)
+
+		decommission := getMarketplaceConfig().Status.Decommission
+		Expect(decommission).ToNot(BeNil())
+		Expect(decommission.Phase).To(Equal(marketplacev1alpha1.DecommissionPhaseFinalReport))
+		Expect(decommission.Message).To(ContainSubstring("Waiting for the final report"))
+		Expect(unregistered).To(BeFalse())
+
+		report := &marketplacev1alpha1.MeterReport{}
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{
+			Name:      decommission.FinalReport,
+			Namespace: namespace,
+		}, report)).To(Succeed())
+		Expect(report.Spec.StartTime.Time).To(BeTemporally("==", lastReportAt))
+		Expect(report.Spec.EndTime.Time).To(BeTemporally("==", decommission.StartTime.Time))
+
+		uploadID := types.UID("receipt")
+		report.Status.UploadID = &uploadID
+		Expect(r.Client.Status().Update(context.TODO(), report)).To(Succeed())
+
+		reconcileTimes(10)
+
+		decommission = getMarketplaceConfig().Status.Decommission
+		Expect(decommission.Phase).To(Equal(marketplacev1alpha1.DecommissionPhaseComplete))
+		Expect(decommission.CompletionTime).ToNot(BeNil())
+		Expect(unregistered).To(BeTrue())
+
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{
+			Name:      utils.RAZEE_NAME,
+			Namespace: namespace,
+		}, &marketplacev1alpha1.RazeeDeployment{})).To(MatchError(ContainSubstring("not found")))
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{
+			Name:      utils.METERBASE_NAME,
+			Namespace: namespace,
+		}, &marketplacev1alpha1.MeterBase{})).To(MatchError(ContainSubstring("not found")))
+	})
+
+	It("should remove the finalizer of a decommissioned config", func() {
+		marketplaceConfig := getMarketplaceConfig()
+		now := metav1.Now()
+		marketplaceConfig.DeletionTimestamp = &now
+		marketplaceConfig.Status.Decommission = &marketplacev1alpha1.DecommissionStatus{
+			Phase:     marketplacev1alpha1.DecommissionPhaseComplete,
+			StartTime: now,
+		}
+		Expect(r.Client.Update(context.TODO(), marketplaceConfig)).To(Succeed())
+
+		reconcileTimes(1)
+
+		Expect(getMarketplaceConfig().Finalizers).To(BeEmpty())
+	})
+})
+
+var _ = Describe("MarketplaceConfig feature gates", func() {
+	const namespace = "openshift-redhat-marketplace"
+
+	var (
+		r   *MarketplaceConfigReconciler
+		req = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      utils.MARKETPLACECONFIG_NAME,
+				Namespace: namespace,
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		s := runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(marketplacev1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(operatorsv1alpha1.AddToScheme(s)).To(Succeed())
+
+		marketplaceconfig := utils.BuildMarketplaceConfigCR(namespace, "accountid")
+		marketplaceconfig.Spec.ClusterUUID = "test"
+		marketplaceconfig.Spec.NamespaceLabelSelector = &metav1.LabelSelector{}
+		marketplaceconfig.Spec.Features = &common.Features{
+			Gates: map[string]bool{
+				string(featuregate.Metering):  false,
+				string(featuregate.Reporting): false,
+			},
+		}
+
+		client := fake.NewFakeClientWithScheme(s,
+			marketplaceconfig,
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   namespace,
+					Labels: map[string]string{utils.LicenseServerTag: "true"},
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      utils.RHMPullSecretName,
+					Namespace: namespace,
+				},
+			},
+		)
+
+		r = &MarketplaceConfigReconciler{
+			Client: client,
+			Scheme: s,
+			Log:    logf.Log.WithName("marketplaceconfig"),
+			cc:     reconcileutils.NewLoglessClientCommand(client, s),
+			cfg: &config.OperatorConfig{
+				DeployedNamespace: namespace,
+				FeatureGate:       featuregate.NewFeatureGate(),
+			},
+		}
+	})
+
+	It("should set the gates from the spec and report them", func() {
+		for i := 0; i < 10; i++ {
+			_, err := r.Reconcile(req)
+			Expect(err).To(Succeed())
+		}
+
+		Expect(r.cfg.FeatureGate.Enabled(featuregate.Metering)).To(BeFalse())
+		Expect(r.cfg.FeatureGate.Enabled(featuregate.Deployment)).To(BeTrue())
+
+		marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
+		Expect(r.Client.Get(context.TODO(), req.NamespacedName, marketplaceConfig)).To(Succeed())
+		Expect(marketplaceConfig.Status.FeatureGates).To(ContainElement(marketplacev1alpha1.FeatureGateStatus{
+			Name:     string(featuregate.Reporting),
+			Maturity: string(featuregate.GA),
+			Enabled:  false,
+		}))
+		Expect(marketplaceConfig.Status.FeatureGates).To(ContainElement(marketplacev1alpha1.FeatureGateStatus{
+			Name:     string(featuregate.ResourceRecommendations),
+			Maturity: string(featuregate.Beta),
+			Enabled:  true,
+		}))
+
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{
+			Name:      utils.METERBASE_NAME,
+			Namespace: namespace,
+		}, &marketplacev1alpha1.MeterBase{})).To(MatchError(ContainSubstring("not found")))
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{
+			Name:      utils.IBM_CATALOGSRC_NAME,
+			Namespace: utils.OPERATOR_MKTPLACE_NS,
+		}, &operatorsv1alpha1.CatalogSource{})).To(Succeed())
+	})
+
+	It("should load the gates before reconciling and reset them on delete", func() {
+		Expect(r.loadFeatureGates(r.Client)).To(Succeed())
+		Expect(r.cfg.FeatureGate.Enabled(featuregate.Metering)).To(BeFalse())
+
+		marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
+		Expect(r.Client.Get(context.TODO(), req.NamespacedName, marketplaceConfig)).To(Succeed())
+		Expect(r.Client.Delete(context.TODO(), marketplaceConfig)).To(Succeed())
+
+		_, err := r.Reconcile(req)
+		Expect(err).To(Succeed())
+		Expect(r.cfg.FeatureGate.Enabled(featuregate.Metering)).To(BeTrue())
+
+		Expect(r.loadFeatureGates(r.Client)).To(Succeed())
+		Expect(r.cfg.FeatureGate.Enabled(featuregate.Metering)).To(BeTrue())
+	})
+})
+
+var _ = Describe("catalog sources", func() {
+	var (
+		namespace = "openshift-redhat-marketplace"
+		r         *MarketplaceConfigReconciler
+	)
+
+	catalogSource := func(name string, labels map[string]string) *operatorsv1alpha1.CatalogSource {
+		return &operatorsv1alpha1.CatalogSource{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: utils.OPERATOR_MKTPLACE_NS, Labels: labels},
+			Spec:       operatorsv1alpha1.CatalogSourceSpec{SourceType: operatorsv1alpha1.SourceTypeGrpc, Image: "quay.io/example/" + name},
+		}
+	}
+
+	BeforeEach(func() {
+		s := runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(marketplacev1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(operatorsv1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(openshiftoperatorv1alpha1.AddToScheme(s)).To(Succeed())
+
+		marketplaceconfig := utils.BuildMarketplaceConfigCR(namespace, "accountid")
+		marketplaceconfig.Spec.InstallIBMCatalogSource = ptr.Bool(true)
+		marketplaceconfig.Spec.CatalogSources = []marketplacev1alpha1.CatalogSourceConfig{
+			{Name: utils.IBM_CATALOGSRC_NAME, Priority: 5},
+			{Name: "custom", Image: "docker.io/ibmcom/custom@sha256:abc", PollInterval: &metav1.Duration{Duration: 10 * time.Minute}},
+		}
+
+		client := fake.NewFakeClientWithScheme(s,
+			marketplaceconfig,
+			utils.BuildNewIBMCatalogSrc(),
+			utils.BuildNewOpencloudCatalogSrc(),
+			catalogSource("removed", map[string]string{catalog.ManagedLabel[0]: catalog.ManagedLabel[1]}),
+			catalogSource("user", nil),
+			&openshiftoperatorv1alpha1.ImageContentSourcePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "mirror"},
+				Spec: openshiftoperatorv1alpha1.ImageContentSourcePolicySpec{
+					RepositoryDigestMirrors: []openshiftoperatorv1alpha1.RepositoryDigestMirrors{
+						{Source: "docker.io/ibmcom", Mirrors: []string{"mirror.local/ibmcom"}},
+					},
+				},
+			},
+		)
+
+		r = &MarketplaceConfigReconciler{
+			Client: client,
+			Scheme: s,
+			Log:    logf.Log.WithName("marketplaceconfig"),
+			cc:     reconcileutils.NewLoglessClientCommand(client, s),
+			cfg: &config.OperatorConfig{
+				DeployedNamespace: namespace,
+				FeatureGate:       featuregate.NewFeatureGate(),
+			},
+		}
+	})
+
+	It("should install the listed catalog sources and remove the rest", func() {
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: utils.MARKETPLACECONFIG_NAME, Namespace: namespace}}
+		marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
+
+		requeue := true
+		for i := 0; requeue && i < 10; i++ {
+			Expect(r.Client.Get(context.TODO(), request.NamespacedName, marketplaceConfig)).To(Succeed())
+
+			var err error
+			requeue, err = r.reconcileCatalogSources(request, marketplaceConfig)
+			Expect(err).To(Succeed())
+		}
+		Expect(requeue).To(BeFalse())
+
+		catalogSrc := &operatorsv1alpha1.CatalogSource{}
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Name: utils.IBM_CATALOGSRC_NAME, Namespace: utils.OPERATOR_MKTPLACE_NS}, catalogSrc)).To(Succeed())
+		Expect(catalogSrc.Spec.Image).To(Equal("docker.io/ibmcom/ibm-operator-catalog"))
+		Expect(catalogSrc.Spec.Priority).To(Equal(5))
+		Expect(catalogSrc.Labels).To(HaveKeyWithValue(catalog.ManagedLabel[0], catalog.ManagedLabel[1]))
+
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Name: "custom", Namespace: utils.OPERATOR_MKTPLACE_NS}, catalogSrc)).To(Succeed())
+		Expect(catalogSrc.Spec.Image).To(Equal("mirror.local/ibmcom/custom@sha256:abc"))
+		Expect(catalogSrc.Spec.UpdateStrategy.RegistryPoll.Interval.Duration).To(Equal(10 * time.Minute))
+
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Name: "removed", Namespace: utils.OPERATOR_MKTPLACE_NS}, catalogSrc)).To(MatchError(ContainSubstring("not found")))
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Name: "user", Namespace: utils.OPERATOR_MKTPLACE_NS}, catalogSrc)).To(Succeed())
+
+		// not installed by the operator, it isn't labelled
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Name: utils.OPENCLOUD_CATALOGSRC_NAME, Namespace: utils.OPERATOR_MKTPLACE_NS}, catalogSrc)).To(Succeed())
+
+		Expect(marketplaceConfig.Status.Conditions.GetCondition(marketplacev1alpha1.ConditionInstalling).Reason).To(Equal(marketplacev1alpha1.ReasonCatalogSourceDelete))
+	})
+})
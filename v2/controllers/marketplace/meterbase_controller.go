@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/gotidy/ptr"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/features"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/manifests"
 	mktypes "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/types"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/operrors"
@@ -38,22 +40,30 @@ import (
 	merrors "emperror.dev/errors"
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
 	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	marketplacev1beta1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
 	prom "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/prometheus"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
 	status "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/status"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	storagev1 "k8s.io/api/storage/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
@@ -77,9 +87,11 @@ type MeterBaseReconciler struct {
 	Log    logr.Logger
 	CC     ClientCommandRunner
 
-	cfg     *config.OperatorConfig
-	factory *manifests.Factory
-	patcher patch.Patcher
+	cfg           *config.OperatorConfig
+	factory       *manifests.Factory
+	patcher       patch.Patcher
+	kubeInterface kubernetes.Interface
+	saClient      *prom.ServiceAccountClient
 }
 
 func (r *MeterBaseReconciler) Inject(injector mktypes.Injectable) mktypes.SetupWithManager {
@@ -108,6 +120,11 @@ func (r *MeterBaseReconciler) InjectFactory(f *manifests.Factory) error {
 	return nil
 }
 
+func (r *MeterBaseReconciler) InjectKubeInterface(k kubernetes.Interface) error {
+	r.kubeInterface = k
+	return nil
+}
+
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
 func (r *MeterBaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	mapFn := handler.ToRequestsFunc(
@@ -122,8 +139,19 @@ func (r *MeterBaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	namespacePredicate := predicates.NamespacePredicate(r.cfg.DeployedNamespace)
 
+	r.Log.Info("feature gates",
+		"metering", r.cfg.FeatureGate.Enabled(features.Metering),
+		"reporting", r.cfg.FeatureGate.Enabled(features.Reporting),
+		"resourceRecommendations", r.cfg.FeatureGate.Enabled(features.ResourceRecommendations))
+
+	// reconcile the meterbase each time the feature gates change
+	meterBase := utils.BuildMeterBaseCr(r.cfg.DeployedNamespace)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&marketplacev1alpha1.MeterBase{}).
+		Watches(
+			r.cfg.FeatureGate.Source(meterBase, meterBase),
+			&handler.EnqueueRequestForObject{}).
 		Watches(
 			&source.Kind{Type: &corev1.ConfigMap{}},
 			&handler.EnqueueRequestForOwner{
@@ -154,12 +182,24 @@ func (r *MeterBaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				IsController: true,
 				OwnerType:    &marketplacev1alpha1.MeterBase{}},
 			builder.WithPredicates(namespacePredicate)).
+		Watches(
+			&source.Kind{Type: &policyv1beta1.PodDisruptionBudget{}},
+			&handler.EnqueueRequestForOwner{
+				IsController: true,
+				OwnerType:    &marketplacev1alpha1.MeterBase{}},
+			builder.WithPredicates(namespacePredicate)).
 		Watches(
 			&source.Kind{Type: &appsv1.StatefulSet{}},
 			&handler.EnqueueRequestForOwner{
 				IsController: true,
 				OwnerType:    &marketplacev1alpha1.MeterBase{}},
 			builder.WithPredicates(namespacePredicate)).
+		Watches(
+			&source.Kind{Type: &marketplacev1beta1.MeterDefinition{}},
+			&handler.EnqueueRequestsFromMapFunc{
+				ToRequests: mapFn,
+			},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Watches(
 			&source.Kind{Type: &corev1.Namespace{}},
 			&handler.EnqueueRequestsFromMapFunc{
@@ -210,6 +250,7 @@ func (r *MeterBaseReconciler) Reconcile(request reconcile.Request) (reconcile.Re
 				Do(r.uninstallPrometheusOperator(instance, factory)...),
 				Do(r.uninstallPrometheus(instance, factory)...),
 				Do(r.uninstallMetricState(instance, factory)...),
+				Do(r.uninstallKubeStateMetrics(instance, factory)...),
 			)),
 	); !result.Is(Continue) {
 
@@ -224,6 +265,12 @@ func (r *MeterBaseReconciler) Reconcile(request reconcile.Request) (reconcile.Re
 		return result.Return()
 	}
 
+	// the installed stack is left running, deleting the MeterBase removes it
+	if !r.cfg.FeatureGate.Enabled(features.Metering) {
+		reqLogger.Info("metering is disabled, leaving the installed metering stack as is")
+		return reconcile.Result{}, nil
+	}
+
 	// if instance.Enabled == false
 	// return do nothing
 	if !instance.Spec.Enabled {
@@ -258,14 +305,34 @@ func (r *MeterBaseReconciler) Reconcile(request reconcile.Request) (reconcile.Re
 
 	cfg := &corev1.Secret{}
 	prometheus := &monitoringv1.Prometheus{}
-	if result, _ := cc.Do(context.TODO(),
+	installActions := []ClientAction{
+		Do(r.reconcileServingCerts(instance, factory, r.servingCertServices(instance, factory)...)...),
 		Do(r.reconcilePrometheusOperator(instance, factory)...),
 		Do(r.installMetricStateDeployment(instance, factory)...),
+		Do(r.installKubeStateMetrics(instance, factory)...),
 		Do(r.reconcileAdditionalConfigSecret(cc, instance, prometheus, factory, cfg)...),
 		Do(r.reconcilePrometheus(instance, prometheus, factory, cfg)...),
+		Do(r.reconcilePrometheusPodDisruptionBudget(instance, factory)...),
 		Do(r.verifyPVCSize(reqLogger, instance, factory, prometheus)...),
 		Do(r.recyclePrometheusPods(reqLogger, instance, factory, prometheus)...),
-	); !result.Is(Continue) {
+	}
+
+	// an external prometheus scrapes metric-state itself, so the bundled
+	// stack is removed if it was installed before
+	if instance.IsExternalPrometheus() {
+		reqLogger.Info("using external prometheus", "url", instance.Spec.ExternalPrometheus.URL)
+		metricStateService, _ := factory.MetricStateService()
+		installActions = []ClientAction{
+			Do(r.uninstallPrometheus(instance, factory)...),
+			Do(r.uninstallPrometheusOperator(instance, factory)...),
+			Do(r.uninstallKubeStateMetrics(instance, factory)...),
+			Do(r.reconcileServingCerts(instance, factory, metricStateService)...),
+			Do(r.installMetricStateDeployment(instance, factory)...),
+			Do(r.checkExternalPrometheus(reqLogger, instance, factory)...),
+		}
+	}
+
+	if result, _ := cc.Do(context.TODO(), installActions...); !result.Is(Continue) {
 		if result.Is(Error) {
 			reqLogger.Error(result, "error in reconcile")
 			return result.ReturnWithError(merrors.Wrap(result, "error creating prometheus"))
@@ -282,7 +349,9 @@ func (r *MeterBaseReconciler) Reconcile(request reconcile.Request) (reconcile.Re
 	// Set status for prometheus
 
 	prometheusStatefulset := &appsv1.StatefulSet{}
-	if result, err := cc.Do(
+	if instance.IsExternalPrometheus() {
+		reqLogger.Info("skipping prometheus status for external prometheus")
+	} else if result, err := cc.Do(
 		context.TODO(),
 		GetAction(types.NamespacedName{
 			Namespace: instance.Namespace,
@@ -332,6 +401,32 @@ func (r *MeterBaseReconciler) Reconcile(request reconcile.Request) (reconcile.Re
 		return result.Return()
 	}
 
+	if !instance.IsExternalPrometheus() {
+		if result, err := cc.Do(
+			context.TODO(),
+			r.checkStorageSize(reqLogger, instance, prometheus)...,
+		); result.Is(Error) || result.Is(Requeue) {
+			if err != nil {
+				return result.ReturnWithError(merrors.Wrap(err, "error checking prometheus storage"))
+			}
+
+			return result.Return()
+		}
+	}
+
+	if !instance.IsExternalPrometheus() && r.cfg.FeatureGate.Enabled(features.ResourceRecommendations) {
+		if result, err := cc.Do(
+			context.TODO(),
+			r.checkResourceRecommendations(reqLogger, instance, prometheus)...,
+		); result.Is(Error) || result.Is(Requeue) {
+			if err != nil {
+				return result.ReturnWithError(merrors.Wrap(err, "error recommending resources"))
+			}
+
+			return result.Return()
+		}
+	}
+
 	// Update final condition
 
 	message = "Meter Base install complete"
@@ -348,6 +443,11 @@ func (r *MeterBaseReconciler) Reconcile(request reconcile.Request) (reconcile.Re
 		return result.Return()
 	}
 
+	if !r.cfg.FeatureGate.Enabled(features.Reporting) {
+		reqLogger.Info("reporting is disabled")
+		return reconcile.Result{RequeueAfter: time.Hour * 1}, nil
+	}
+
 	meterReportList := &marketplacev1alpha1.MeterReportList{}
 	if result, err := cc.Do(
 		context.TODO(),
@@ -355,7 +455,7 @@ func (r *MeterBaseReconciler) Reconcile(request reconcile.Request) (reconcile.Re
 			ListAction(meterReportList, client.InNamespace(request.Namespace)),
 			OnContinue(Call(func() (ClientAction, error) {
 				loc := time.UTC
-				dateRangeInDays := -30
+				dateRangeInDays := -meterReportBacklogDays
 
 				meterReportNames := r.sortMeterReports(meterReportList)
 
@@ -407,6 +507,10 @@ func (r *MeterBaseReconciler) Reconcile(request reconcile.Request) (reconcile.Re
 
 const promServiceName = "rhm-prometheus-meterbase"
 
+// meterReportBacklogDays is how many days back MeterReports are created and
+// may be re-run for, so Prometheus has to keep their data.
+const meterReportBacklogDays = 30
+
 func (r *MeterBaseReconciler) createReportIfNotFound(expectedCreatedDates []string, foundCreatedDates []string, request reconcile.Request, instance *marketplacev1alpha1.MeterBase) error {
 	reqLogger := r.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 
@@ -527,7 +631,7 @@ func (r *MeterBaseReconciler) generateExpectedDates(endTime time.Time, loc *time
 }
 
 func (r *MeterBaseReconciler) newMeterReport(namespace string, startTime time.Time, endTime time.Time, meterReportName string, instance *marketplacev1alpha1.MeterBase, prometheusServiceName string) *marketplacev1alpha1.MeterReport {
-	return &marketplacev1alpha1.MeterReport{
+	report := &marketplacev1alpha1.MeterReport{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      meterReportName,
 			Namespace: namespace,
@@ -535,13 +639,25 @@ func (r *MeterBaseReconciler) newMeterReport(namespace string, startTime time.Ti
 		Spec: marketplacev1alpha1.MeterReportSpec{
 			StartTime: metav1.NewTime(startTime),
 			EndTime:   metav1.NewTime(endTime),
-			PrometheusService: &common.ServiceReference{
-				Name:       prometheusServiceName,
-				Namespace:  instance.Namespace,
-				TargetPort: intstr.FromString("rbac"),
-			},
 		},
 	}
+
+	if instance.IsExternalPrometheus() {
+		report.Spec.ExternalPrometheus = instance.Spec.ExternalPrometheus.DeepCopy()
+		return report
+	}
+
+	report.Spec.PrometheusService = &common.ServiceReference{
+		Name:       prometheusServiceName,
+		Namespace:  instance.Namespace,
+		TargetPort: intstr.FromString("rbac"),
+	}
+
+	if prom := instance.Spec.Prometheus; prom != nil && prom.RemoteWrite != nil && prom.RemoteWrite.Query != nil {
+		report.Spec.RemoteStore = prom.RemoteWrite.Query.DeepCopy()
+	}
+
+	return report
 }
 
 func (r *MeterBaseReconciler) reconcilePrometheusSubscription(
@@ -636,7 +752,13 @@ func (r *MeterBaseReconciler) installMetricStateDeployment(
 		manifests.CreateOrUpdateFactoryItemAction(
 			deployment,
 			func() (runtime.Object, error) {
-				return factory.MetricStateDeployment()
+				deployment, err := factory.MetricStateDeployment()
+				if err != nil {
+					return nil, err
+				}
+
+				manifests.SetRecommendedResources(instance, marketplacev1alpha1.RecommendationComponentMetricState, deployment.Spec.Template.Spec.Containers)
+				return deployment, nil
 			},
 			args,
 		),
@@ -678,19 +800,6 @@ func (r *MeterBaseReconciler) uninstallPrometheusOperator(
 	}
 }
 
-var ignoreKubeStateList = []string{
-	"kube_configmap.*",
-	"kube_cronjob.*",
-	"kube_daemonset.*",
-	"kube_deployment.*",
-	"kube_endpoint.*",
-	"kube_job.*",
-	"kube_node_status.*",
-	"kube_replicaset.*",
-	"kube_secret.*",
-	"kube_statefulset.*",
-}
-
 func (r *MeterBaseReconciler) reconcileAdditionalConfigSecret(
 	cc ClientCommandRunner,
 	instance *marketplacev1alpha1.MeterBase,
@@ -699,10 +808,11 @@ func (r *MeterBaseReconciler) reconcileAdditionalConfigSecret(
 	additionalConfigSecret *corev1.Secret,
 ) []ClientAction {
 	reqLogger := r.Log.WithValues("func", "reconcileAdditionalConfigSecret", "Request.Namespace", instance.Namespace, "Request.Name", instance.Name)
-	openshiftKubeletMonitor := &monitoringv1.ServiceMonitor{}
-	openshiftKubeStateMonitor := &monitoringv1.ServiceMonitor{}
+	kubeletMonitor := &monitoringv1.ServiceMonitor{}
+	kubeStateMonitor := &monitoringv1.ServiceMonitor{}
 	metricStateMonitor := &monitoringv1.ServiceMonitor{}
 	secretsInNamespace := &corev1.SecretList{}
+	meterDefinitions := &marketplacev1beta1.MeterDefinitionList{}
 
 	sm, err := factory.MetricStateServiceMonitor()
 
@@ -710,63 +820,92 @@ func (r *MeterBaseReconciler) reconcileAdditionalConfigSecret(
 		reqLogger.Error(err, "error getting metric state")
 	}
 
+	getActions := []ClientAction{
+		GetAction(types.NamespacedName{
+			Namespace: sm.ObjectMeta.Namespace,
+			Name:      sm.ObjectMeta.Name,
+		}, metricStateMonitor),
+		ListAction(secretsInNamespace, client.InNamespace(prometheus.GetNamespace())),
+		ListAction(meterDefinitions, client.InNamespace("")),
+	}
+
+	// vanilla clusters have no cluster monitoring to copy the monitors from,
+	// the kubelets and the kube-state-metrics of the operator are scraped
+	if factory.IsKubernetesProfile() {
+		kubeletMonitor = factory.KubeletServiceMonitor()
+		kubeStateMonitor, err = factory.KubeStateMetricsServiceMonitor()
+
+		if err != nil {
+			reqLogger.Error(err, "error getting kube-state-metrics")
+		}
+	} else {
+		getActions = append(getActions,
+			GetAction(types.NamespacedName{
+				Namespace: "openshift-monitoring",
+				Name:      "kubelet",
+			}, kubeletMonitor),
+			GetAction(types.NamespacedName{
+				Namespace: "openshift-monitoring",
+				Name:      "kube-state-metrics",
+			}, kubeStateMonitor),
+		)
+	}
+
 	return []ClientAction{
 		Do(
 			HandleResult(
-				Do(
-					GetAction(types.NamespacedName{
-						Namespace: "openshift-monitoring",
-						Name:      "kubelet",
-					}, openshiftKubeletMonitor),
-					GetAction(types.NamespacedName{
-						Namespace: "openshift-monitoring",
-						Name:      "kube-state-metrics",
-					}, openshiftKubeStateMonitor),
-					GetAction(types.NamespacedName{
-						Namespace: sm.ObjectMeta.Namespace,
-						Name:      sm.ObjectMeta.Name,
-					}, metricStateMonitor),
-					ListAction(secretsInNamespace, client.InNamespace(prometheus.GetNamespace()))),
+				Do(getActions...),
 				OnNotFound(ReturnWithError(errors.New("required serviceMonitor not found"))),
 				OnError(ReturnWithError(errors.New("required serviceMonitor errored")))),
 		),
 		Call(func() (ClientAction, error) {
-			newEndpoints := []monitoringv1.Endpoint{}
-
-			for _, ep := range openshiftKubeStateMonitor.Spec.Endpoints {
-				newEp := ep.DeepCopy()
-				configs := []*monitoringv1.RelabelConfig{
-					{
-						SourceLabels: []string{"__name__"},
-						Action:       "drop",
-						Regex:        fmt.Sprintf("(%s)", strings.Join(ignoreKubeStateList, "|")),
-					},
+			// kube-state and kubelet expose far more series than the meters
+			// query, only the metrics of active meter definitions are kept
+			allowlist := []string{}
+			var undetermined error
+			for i := range meterDefinitions.Items {
+				mdef := &meterDefinitions.Items[i]
+				names, err := meteredMetricNames(mdef)
+				if err != nil {
+					undetermined = merrors.Append(undetermined, merrors.WithMessagef(err, "meterdefinition %s/%s", mdef.Namespace, mdef.Name))
 				}
-				newEp.RelabelConfigs = append(configs, ep.RelabelConfigs...)
-				newEndpoints = append(newEndpoints, *newEp)
+
+				allowlist = append(allowlist, names...)
 			}
 
-			openshiftKubeStateMonitor.Spec.Endpoints = newEndpoints
+			// a partial allowlist would drop the metrics of the meters it misses
+			if undetermined != nil {
+				reqLogger.Info("keeping every metric, metric names of the meters can't be determined", "reason", undetermined.Error())
+				allowlist = nil
+			}
+
+			statusActions := []ClientAction{}
+			if instance.Status.Conditions.SetCondition(metricAllowlistCondition(undetermined)) {
+				statusActions = append(statusActions, UpdateAction(instance, UpdateStatusOnly(true)))
+			}
 
 			sMons := map[string]*monitoringv1.ServiceMonitor{
-				"kube-state": openshiftKubeStateMonitor,
-				"kubelet":    openshiftKubeletMonitor,
+				"kube-state": prom.AllowlistServiceMonitor(kubeStateMonitor, allowlist),
+				"kubelet":    prom.AllowlistServiceMonitorWithUsage(kubeletMonitor, allowlist, prometheus.Namespace),
 			}
 			sMons[metricStateMonitor.Name] = metricStateMonitor
 
+			pMons := map[string]*monitoringv1.PodMonitor{}
+			r.addScrapeTargets(reqLogger, meterDefinitions, sMons, pMons)
+
 			cfgGen := prom.NewConfigGenerator(reqLogger)
 
-			basicAuthSecrets, err := prom.LoadBasicAuthSecrets(r.Client, sMons, prometheus.Spec.RemoteRead, prometheus.Spec.RemoteWrite, prometheus.Spec.APIServerConfig, secretsInNamespace)
+			basicAuthSecrets, err := prom.LoadBasicAuthSecrets(r.Client, sMons, pMons, prometheus.Spec.RemoteRead, prometheus.Spec.RemoteWrite, prometheus.Spec.APIServerConfig, secretsInNamespace)
 			if err != nil {
 				return nil, err
 			}
 
-			bearerTokens, err := prom.LoadBearerTokensFromSecrets(r.Client, sMons)
+			bearerTokens, err := prom.LoadBearerTokensFromSecrets(r.Client, sMons, pMons)
 			if err != nil {
 				return nil, err
 			}
 
-			cfg, err := cfgGen.GenerateConfig(prometheus, sMons, basicAuthSecrets, bearerTokens, []string{})
+			cfg, err := cfgGen.GenerateConfig(prometheus, sMons, pMons, basicAuthSecrets, bearerTokens, []string{})
 
 			if err != nil {
 				return nil, err
@@ -784,21 +923,138 @@ func (r *MeterBaseReconciler) reconcileAdditionalConfigSecret(
 				return nil, err
 			}
 
-			return HandleResult(
-				GetAction(key, additionalConfigSecret),
-				OnNotFound(CreateAction(sec, CreateWithAddController(instance))),
-				OnContinue(Call(func() (ClientAction, error) {
+			return Do(append(statusActions,
+				HandleResult(
+					GetAction(key, additionalConfigSecret),
+					OnNotFound(CreateAction(sec, CreateWithAddController(instance))),
+					OnContinue(Call(func() (ClientAction, error) {
 
-					if reflect.DeepEqual(additionalConfigSecret.Data, sec.Data) {
-						return nil, nil
-					}
+						if reflect.DeepEqual(additionalConfigSecret.Data, sec.Data) {
+							return nil, nil
+						}
 
-					return UpdateAction(sec), nil
-				}))), nil
+						return UpdateAction(sec), nil
+					}))))...), nil
 		}),
 	}
 }
 
+// meteredMetricNames returns the metric names the meters of an active meter
+// definition query.
+func meteredMetricNames(
+	mdef *marketplacev1beta1.MeterDefinition,
+) ([]string, error) {
+	if mdef.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+
+	queries := []string{}
+	for _, meter := range mdef.Spec.Meters {
+		queries = append(queries, meter.Query)
+	}
+
+	return prom.MeteredMetricNames(queries...)
+}
+
+// metricAllowlistCondition reports if the metrics kube-state and the kubelets
+// are scraped for are limited to the metrics of the meters.
+func metricAllowlistCondition(undetermined error) status.Condition {
+	if undetermined != nil {
+		return status.Condition{
+			Type:    marketplacev1alpha1.ConditionMetricNamesUndetermined,
+			Status:  corev1.ConditionTrue,
+			Reason:  marketplacev1alpha1.ReasonMetricNamesUndetermined,
+			Message: fmt.Sprintf("every metric is kept: %s", undetermined.Error()),
+		}
+	}
+
+	return status.Condition{
+		Type:    marketplacev1alpha1.ConditionMetricNamesUndetermined,
+		Status:  corev1.ConditionFalse,
+		Reason:  marketplacev1alpha1.ReasonMetricNamesDetermined,
+		Message: "only the metrics of the meters are kept",
+	}
+}
+
+// addScrapeTargets adds the monitors the meter definitions declare as scrape
+// targets. A monitor keeps the metrics of every meter definition targeting
+// it. Missing and unsafe monitors are logged and skipped so one meter
+// definition can't break the metering config.
+func (r *MeterBaseReconciler) addScrapeTargets(
+	reqLogger logr.Logger,
+	meterDefinitions *marketplacev1beta1.MeterDefinitionList,
+	sMons map[string]*monitoringv1.ServiceMonitor,
+	pMons map[string]*monitoringv1.PodMonitor,
+) {
+	metricNames := map[marketplacev1beta1.ScrapeTarget]map[types.NamespacedName][]string{}
+	undetermined := map[types.NamespacedName]bool{}
+
+	for _, mdef := range meterDefinitions.Items {
+		if len(mdef.Spec.ScrapeTargets) == 0 {
+			continue
+		}
+
+		names, err := meteredMetricNames(&mdef)
+		if err != nil {
+			reqLogger.Info("keeping every metric of the scrape targets", "meterdef", mdef.Name, "namespace", mdef.Namespace, "reason", err.Error())
+		}
+
+		for _, target := range mdef.Spec.ScrapeTargets {
+			if metricNames[target] == nil {
+				metricNames[target] = map[types.NamespacedName][]string{}
+			}
+
+			key := types.NamespacedName{Name: target.Name, Namespace: mdef.Namespace}
+			metricNames[target][key] = append(metricNames[target][key], names...)
+			undetermined[key] = undetermined[key] || err != nil
+		}
+	}
+
+	for target, monitors := range metricNames {
+		for key, names := range monitors {
+			// a monitor is only scraped for the metrics of the meters, all of
+			// them if the names of a meter targeting it are unknown
+			if undetermined[key] {
+				names = nil
+			} else if len(names) == 0 {
+				continue
+			}
+
+			identifier := fmt.Sprintf("scrapeTarget/%s/%s", key.Namespace, key.Name)
+
+			switch target.Kind {
+			case marketplacev1beta1.ScrapeTargetServiceMonitor:
+				mon := &monitoringv1.ServiceMonitor{}
+				if err := r.Client.Get(context.TODO(), key, mon); err != nil {
+					reqLogger.Error(err, "failed to get scrape target", "kind", target.Kind, "name", key)
+					continue
+				}
+
+				// rejections are reported on the meter definitions by their controller
+				if err := prom.CheckServiceMonitor(mon); err != nil {
+					reqLogger.Info("skipping unsafe scrape target", "kind", target.Kind, "name", key, "reason", err.Error())
+					continue
+				}
+
+				sMons[identifier] = prom.MeteredServiceMonitor(mon, names)
+			case marketplacev1beta1.ScrapeTargetPodMonitor:
+				mon := &monitoringv1.PodMonitor{}
+				if err := r.Client.Get(context.TODO(), key, mon); err != nil {
+					reqLogger.Error(err, "failed to get scrape target", "kind", target.Kind, "name", key)
+					continue
+				}
+
+				if err := prom.CheckPodMonitor(mon); err != nil {
+					reqLogger.Info("skipping unsafe scrape target", "kind", target.Kind, "name", key, "reason", err.Error())
+					continue
+				}
+
+				pMons[identifier] = prom.MeteredPodMonitor(mon, names)
+			}
+		}
+	}
+}
+
 func (r *MeterBaseReconciler) reconcilePrometheus(
 	instance *marketplacev1alpha1.MeterBase,
 	prometheus *monitoringv1.Prometheus,
@@ -810,61 +1066,26 @@ func (r *MeterBaseReconciler) reconcilePrometheus(
 		Patcher: r.patcher,
 	}
 
-	dataSecret := &corev1.Secret{}
-	kubeletCertsCM := &corev1.ConfigMap{}
-
-	return []ClientAction{
+	actions := []ClientAction{
 		manifests.CreateIfNotExistsFactoryItem(
 			&corev1.ConfigMap{},
 			func() (runtime.Object, error) {
 				return factory.PrometheusServingCertsCABundle()
 			},
 		),
-		manifests.CreateIfNotExistsFactoryItem(
-			dataSecret,
-			func() (runtime.Object, error) {
-				return factory.PrometheusDatasources()
-			}),
-		manifests.CreateIfNotExistsFactoryItem(
-			&corev1.Secret{},
-			func() (runtime.Object, error) {
-				return factory.PrometheusProxySecret()
-			}),
 		manifests.CreateIfNotExistsFactoryItem(
 			&corev1.Secret{},
 			func() (runtime.Object, error) {
 				return factory.PrometheusRBACProxySecret()
 			},
 		),
-		HandleResult(manifests.CreateIfNotExistsFactoryItem(
-			&corev1.Secret{},
-			func() (runtime.Object, error) {
-				data, ok := dataSecret.Data["basicAuthSecret"]
-
-				if !ok {
-					return nil, merrors.New("basicAuthSecret not on data")
-				}
+	}
 
-				return factory.PrometheusHtpasswdSecret(string(data))
-			}),
-			OnError(RequeueResponse()),
-		),
-		HandleResult(
-			GetAction(
-				types.NamespacedName{Namespace: "openshift-monitoring", Name: "kubelet-serving-ca-bundle"},
-				kubeletCertsCM,
-			),
-			OnNotFound(Call(func() (ClientAction, error) {
-				return nil, merrors.New("require kubelet-serving configmap is not found")
-			})),
-			OnContinue(manifests.CreateOrUpdateFactoryItemAction(
-				&corev1.ConfigMap{},
-				func() (runtime.Object, error) {
-					return factory.PrometheusKubeletServingCABundle(kubeletCertsCM.Data["ca-bundle.crt"])
-				},
-				args,
-			))),
+	if !factory.IsKubernetesProfile() {
+		actions = append(actions, r.reconcilePrometheusOpenshiftDependencies(factory, args)...)
+	}
 
+	return append(actions,
 		manifests.CreateOrUpdateFactoryItemAction(
 			&corev1.Service{},
 			func() (runtime.Object, error) {
@@ -934,6 +1155,57 @@ func (r *MeterBaseReconciler) reconcilePrometheus(
 					), nil
 				},
 				))),
+	)
+}
+
+// reconcilePrometheusOpenshiftDependencies creates the secrets of the OAuth
+// proxy and copies the kubelet CA of cluster monitoring.
+func (r *MeterBaseReconciler) reconcilePrometheusOpenshiftDependencies(
+	factory *manifests.Factory,
+	args manifests.CreateOrUpdateFactoryItemArgs,
+) []ClientAction {
+	dataSecret := &corev1.Secret{}
+	kubeletCertsCM := &corev1.ConfigMap{}
+
+	return []ClientAction{
+		manifests.CreateIfNotExistsFactoryItem(
+			dataSecret,
+			func() (runtime.Object, error) {
+				return factory.PrometheusDatasources()
+			}),
+		manifests.CreateIfNotExistsFactoryItem(
+			&corev1.Secret{},
+			func() (runtime.Object, error) {
+				return factory.PrometheusProxySecret()
+			}),
+		HandleResult(manifests.CreateIfNotExistsFactoryItem(
+			&corev1.Secret{},
+			func() (runtime.Object, error) {
+				data, ok := dataSecret.Data["basicAuthSecret"]
+
+				if !ok {
+					return nil, merrors.New("basicAuthSecret not on data")
+				}
+
+				return factory.PrometheusHtpasswdSecret(string(data))
+			}),
+			OnError(RequeueResponse()),
+		),
+		HandleResult(
+			GetAction(
+				types.NamespacedName{Namespace: "openshift-monitoring", Name: "kubelet-serving-ca-bundle"},
+				kubeletCertsCM,
+			),
+			OnNotFound(Call(func() (ClientAction, error) {
+				return nil, merrors.New("require kubelet-serving configmap is not found")
+			})),
+			OnContinue(manifests.CreateOrUpdateFactoryItemAction(
+				&corev1.ConfigMap{},
+				func() (runtime.Object, error) {
+					return factory.PrometheusKubeletServingCABundle(kubeletCertsCM.Data["ca-bundle.crt"])
+				},
+				args,
+			))),
 	}
 }
 
@@ -947,7 +1219,7 @@ func (r *MeterBaseReconciler) recyclePrometheusPods(
 
 	return []ClientAction{
 		Call(func() (ClientAction, error) {
-			return ListAction(pvcs, client.MatchingLabels{"prometheus": prometheusDeployment.Name}), nil
+			return ListAction(pvcs, client.InNamespace(prometheusDeployment.Namespace), client.MatchingLabels{"prometheus": prometheusDeployment.Name}), nil
 		}),
 		Call(func() (ClientAction, error) {
 			if len(pvcs.Items) == 0 {
@@ -990,7 +1262,7 @@ func (r *MeterBaseReconciler) verifyPVCSize(
 
 	return []ClientAction{
 		Call(func() (ClientAction, error) {
-			return ListAction(pvcs, client.MatchingLabels{"prometheus": prometheusDeployment.Name}), nil
+			return ListAction(pvcs, client.InNamespace(prometheusDeployment.Namespace), client.MatchingLabels{"prometheus": prometheusDeployment.Name}), nil
 		}),
 		HandleResult(
 			Call(func() (ClientAction, error) {
@@ -1059,42 +1331,545 @@ func (r *MeterBaseReconciler) verifyPVCSize(
 	}
 }
 
-func (r *MeterBaseReconciler) uninstallMetricState(
+// checkStorageSize estimates the storage Prometheus needs for the retention
+// from the series in the TSDB head and raises the StorageUnderSized
+// condition when the pvcs are smaller. With AutoExpand the storage size is
+// raised to the estimate and verifyPVCSize expands the pvcs.
+func (r *MeterBaseReconciler) checkStorageSize(
+	log logr.Logger,
 	instance *marketplacev1alpha1.MeterBase,
-	factory *manifests.Factory,
+	prometheusDeployment *monitoringv1.Prometheus,
 ) []ClientAction {
-	deployment, _ := factory.MetricStateDeployment()
-	service, _ := factory.MetricStateService()
-	sm, _ := factory.MetricStateServiceMonitor()
+	pvcs := &corev1.PersistentVolumeClaimList{}
 
 	return []ClientAction{
-		HandleResult(
-			GetAction(types.NamespacedName{Namespace: sm.Namespace, Name: sm.Name}, sm),
-			OnContinue(DeleteAction(sm))),
-		HandleResult(
-			GetAction(types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service),
-			OnContinue(DeleteAction(service))),
-		HandleResult(
-			GetAction(types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}, deployment),
-			OnContinue(DeleteAction(deployment))),
+		Call(func() (ClientAction, error) {
+			return ListAction(pvcs, client.InNamespace(prometheusDeployment.Namespace), client.MatchingLabels{"prometheus": prometheusDeployment.Name}), nil
+		}),
+		Call(func() (ClientAction, error) {
+			// an empty dir is not kept across restarts so there is nothing to size
+			if instance.Spec.Prometheus == nil || instance.Spec.Prometheus.Storage.EmptyDir != nil {
+				return nil, nil
+			}
+
+			estimate, err := r.estimateStorage(prometheusDeployment)
+
+			// the estimate is best effort, prometheus may not be ready yet
+			if err != nil {
+				log.Info("failed to estimate prometheus storage", "err", err.Error())
+				return nil, nil
+			}
+
+			// already parsed by estimateStorage
+			retention, _ := model.ParseDuration(prometheusDeployment.Spec.Retention)
+
+			storage := &instance.Spec.Prometheus.Storage
+			required := estimate.DeepCopy()
+			required.Add(resource.MustParse(manifests.PrometheusStorageHeadroom))
+			log.Info("estimated prometheus storage", "estimate", estimate.String(), "required", required.String())
+
+			if storage.AutoExpand && storage.Size.Cmp(required) < 0 {
+				updated := instance.DeepCopy()
+				updated.Spec.Prometheus.Storage.Size = expandedStorageSize(required)
+				log.Info("expanding prometheus storage", "oldSize", storage.Size.String(), "newSize", updated.Spec.Prometheus.Storage.Size.String())
+
+				return HandleResult(
+					UpdateAction(updated),
+					OnContinue(RequeueResponse()),
+				), nil
+			}
+
+			pvcSize := storage.Size.DeepCopy()
+			for _, pvc := range pvcs.Items {
+				capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+				if ok && capacity.Cmp(pvcSize) < 0 {
+					pvcSize = capacity
+				}
+			}
+
+			updated := false
+			if instance.Status.EstimatedStorage == nil || instance.Status.EstimatedStorage.Cmp(estimate) != 0 {
+				instance.Status.EstimatedStorage = &estimate
+				updated = true
+			}
+
+			condition := storageSizeCondition(storage, time.Duration(retention), pvcSize, required)
+			updated = instance.Status.Conditions.SetCondition(condition) || updated
+
+			if !updated {
+				return nil, nil
+			}
+
+			return UpdateAction(instance, UpdateStatusOnly(true)), nil
+		}),
 	}
 }
 
-func (r *MeterBaseReconciler) uninstallPrometheus(
-	instance *marketplacev1alpha1.MeterBase,
-	factory *manifests.Factory,
-) []ClientAction {
-	cm0, _ := factory.PrometheusServingCertsCABundle()
-	secret0, _ := factory.PrometheusDatasources()
+// estimateStorage returns the storage needed to keep the series currently in
+// the TSDB head for the retention of the Prometheus.
+func (r *MeterBaseReconciler) estimateStorage(
+	prometheusDeployment *monitoringv1.Prometheus,
+) (resource.Quantity, error) {
+	promAPI, err := r.newPromAPI(context.TODO(), prometheusDeployment.Namespace)
+	if err != nil {
+		return resource.Quantity{}, err
+	}
+
+	stats, err := promAPI.HeadStats(context.TODO())
+	if err != nil {
+		return resource.Quantity{}, err
+	}
+
+	retention, err := model.ParseDuration(prometheusDeployment.Spec.Retention)
+	if err != nil {
+		return resource.Quantity{}, merrors.Wrap(err, "failed to parse retention")
+	}
+
+	var scrapeInterval model.Duration
+	if prometheusDeployment.Spec.ScrapeInterval != "" {
+		scrapeInterval, err = model.ParseDuration(prometheusDeployment.Spec.ScrapeInterval)
+		if err != nil {
+			return resource.Quantity{}, merrors.Wrap(err, "failed to parse scrape interval")
+		}
+	}
+
+	return prom.EstimateStorage(stats.NumSeries, time.Duration(scrapeInterval), time.Duration(retention)), nil
+}
+
+// newPromAPI returns the api of the Prometheus installed by the MeterBase.
+func (r *MeterBaseReconciler) newPromAPI(ctx context.Context, namespace string) (*prom.PrometheusAPI, error) {
+	service := &corev1.Service{}
+	certConfigMap := &corev1.ConfigMap{}
+
+	if result, _ := r.CC.Do(ctx,
+		GetAction(types.NamespacedName{Name: utils.PROMETHEUS_METERBASE_NAME, Namespace: namespace}, service),
+		GetAction(types.NamespacedName{Name: utils.OPERATOR_CERTS_CA_BUNDLE_NAME, Namespace: r.cfg.ControllerValues.DeploymentNamespace}, certConfigMap),
+	); !result.Is(Continue) {
+		return nil, merrors.Wrap(result, "failed to get prometheus service")
+	}
+
+	cert, err := parseCertificateFromConfigMap(*certConfigMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// the client keeps the token so it is only requested again near its expiry
+	if r.saClient == nil {
+		r.saClient = prom.NewServiceAccountClient(r.cfg.ControllerValues.DeploymentNamespace, r.kubeInterface)
+	}
+
+	authToken, err := r.saClient.NewServiceAccountToken(utils.OPERATOR_SERVICE_ACCOUNT, utils.PrometheusAudience, 3600, r.Log)
+	if err != nil {
+		return nil, err
+	}
+
+	return prom.NewPromAPI(service, &cert, authToken)
+}
+
+// storageSizeCondition checks the retention covers the report backlog and
+// the pvc can hold the required storage.
+func storageSizeCondition(
+	storage *marketplacev1alpha1.StorageSpec,
+	retention time.Duration,
+	pvcSize, required resource.Quantity,
+) status.Condition {
+	backlog := meterReportBacklogDays * 24 * time.Hour
+
+	switch {
+	case retention < backlog:
+		return status.Condition{
+			Type:    marketplacev1alpha1.ConditionStorageUnderSized,
+			Status:  corev1.ConditionTrue,
+			Reason:  marketplacev1alpha1.ReasonRetentionBelowBacklog,
+			Message: fmt.Sprintf("retention %s is shorter than the %d days reports may be re-run for", model.Duration(retention), meterReportBacklogDays),
+		}
+	case pvcSize.Cmp(required) >= 0:
+		return status.Condition{
+			Type:    marketplacev1alpha1.ConditionStorageUnderSized,
+			Status:  corev1.ConditionFalse,
+			Reason:  marketplacev1alpha1.ReasonStorageSufficient,
+			Message: fmt.Sprintf("storage %s covers the estimated %s", pvcSize.String(), required.String()),
+		}
+	case storage.AutoExpand:
+		return status.Condition{
+			Type:    marketplacev1alpha1.ConditionStorageUnderSized,
+			Status:  corev1.ConditionTrue,
+			Reason:  marketplacev1alpha1.ReasonStorageExpanding,
+			Message: fmt.Sprintf("storage %s is expanding to %s", pvcSize.String(), storage.Size.String()),
+		}
+	default:
+		return status.Condition{
+			Type:    marketplacev1alpha1.ConditionStorageUnderSized,
+			Status:  corev1.ConditionTrue,
+			Reason:  marketplacev1alpha1.ReasonStorageBelowEstimate,
+			Message: fmt.Sprintf("storage %s is below the estimated %s, set storage.autoExpand or raise storage.size", pvcSize.String(), required.String()),
+		}
+	}
+}
+
+// expandedStorageSize rounds the required storage up to the next Gi.
+func expandedStorageSize(required resource.Quantity) resource.Quantity {
+	gi := int64(1 << 30)
+	size := (required.Value() + gi - 1) / gi
+
+	return resource.MustParse(fmt.Sprintf("%dGi", size))
+}
+
+const (
+	// recommendationWindow is the usage the resources are recommended from.
+	recommendationWindow = 7 * 24 * time.Hour
+
+	// recommendationInterval is how often the usage is read again.
+	recommendationInterval = time.Hour
+)
+
+// checkResourceRecommendations recommends the resources of Prometheus,
+// metric-state and the reporter from their usage over the last week. With
+// AutoApplyRecommendations the components pick the recommendations up on
+// the next reconcile.
+func (r *MeterBaseReconciler) checkResourceRecommendations(
+	log logr.Logger,
+	instance *marketplacev1alpha1.MeterBase,
+	prometheusDeployment *monitoringv1.Prometheus,
+) []ClientAction {
+	return []ClientAction{
+		Call(func() (ClientAction, error) {
+			now := time.Now()
+
+			if !recommendationsDue(instance.Status.Recommendations, now) {
+				return nil, nil
+			}
+
+			recommendations, err := r.recommendResources(prometheusDeployment)
+
+			// recommendations are best effort, prometheus may not be ready yet
+			if err != nil {
+				log.Info("failed to recommend resources", "err", err.Error())
+				return nil, nil
+			}
+
+			log.Info("recommended resources", "containers", len(recommendations))
+
+			instance.Status.Recommendations = &marketplacev1alpha1.ResourceRecommendations{
+				LastUpdateTime: metav1.NewTime(now),
+				Containers:     recommendations,
+			}
+
+			if instance.Spec.AutoApplyRecommendations {
+				return HandleResult(
+					UpdateAction(instance, UpdateStatusOnly(true)),
+					OnContinue(RequeueResponse()),
+				), nil
+			}
+
+			return UpdateAction(instance, UpdateStatusOnly(true)), nil
+		}),
+	}
+}
+
+// recommendResources reads the usage of the pods of each component from the
+// Prometheus and recommends the resources of their containers.
+func (r *MeterBaseReconciler) recommendResources(
+	prometheusDeployment *monitoringv1.Prometheus,
+) ([]marketplacev1alpha1.ContainerResourceRecommendation, error) {
+	ctx := context.TODO()
+
+	promAPI, err := r.newPromAPI(ctx, prometheusDeployment.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations := []marketplacev1alpha1.ContainerResourceRecommendation{}
+
+	for _, component := range recommendationComponents(prometheusDeployment) {
+		usage := map[float64]map[string]prom.ContainerUsage{}
+
+		for _, quantile := range []float64{prom.RequestQuantile, prom.LimitQuantile} {
+			usage[quantile], err = promAPI.QueryContainerUsage(ctx, &prom.ContainerUsageQuery{
+				Namespace: prometheusDeployment.Namespace,
+				PodRegex:  component.podRegex,
+				Quantile:  quantile,
+				Window:    model.Duration(recommendationWindow),
+				Step:      model.Duration(5 * time.Minute),
+			})
+
+			if err != nil {
+				return nil, merrors.WrapWithDetails(err, "failed to query container usage", "component", component.name)
+			}
+		}
+
+		recommendations = append(recommendations,
+			prom.RecommendResources(component.name, usage[prom.RequestQuantile], usage[prom.LimitQuantile])...)
+	}
+
+	return recommendations, nil
+}
+
+type recommendationComponent struct {
+	name     string
+	podRegex string
+}
+
+// recommendationComponents returns the components with the pods they run.
+func recommendationComponents(prometheusDeployment *monitoringv1.Prometheus) []recommendationComponent {
+	return []recommendationComponent{
+		{
+			name:     marketplacev1alpha1.RecommendationComponentPrometheus,
+			podRegex: fmt.Sprintf("prometheus-%s-[0-9]+", regexp.QuoteMeta(prometheusDeployment.Name)),
+		},
+		{
+			name:     marketplacev1alpha1.RecommendationComponentMetricState,
+			podRegex: "rhm-metric-state-.+",
+		},
+		{
+			name:     marketplacev1alpha1.RecommendationComponentReporter,
+			podRegex: regexp.QuoteMeta(utils.METER_REPORT_PREFIX) + ".+",
+		},
+	}
+}
+
+// recommendationsDue returns true if the recommendations are missing or
+// older than the recommendation interval.
+func recommendationsDue(recommendations *marketplacev1alpha1.ResourceRecommendations, now time.Time) bool {
+	return recommendations == nil || now.Sub(recommendations.LastUpdateTime.Time) >= recommendationInterval
+}
+
+func (r *MeterBaseReconciler) uninstallMetricState(
+	instance *marketplacev1alpha1.MeterBase,
+	factory *manifests.Factory,
+) []ClientAction {
+	deployment, _ := factory.MetricStateDeployment()
+	service, _ := factory.MetricStateService()
+	sm, _ := factory.MetricStateServiceMonitor()
+
+	return []ClientAction{
+		HandleResult(
+			GetAction(types.NamespacedName{Namespace: sm.Namespace, Name: sm.Name}, sm),
+			OnContinue(DeleteAction(sm))),
+		HandleResult(
+			GetAction(types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service),
+			OnContinue(DeleteAction(service))),
+		HandleResult(
+			GetAction(types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}, deployment),
+			OnContinue(DeleteAction(deployment))),
+	}
+}
+
+// servingCertServices returns the services of the bundled stack that are
+// served with a serving certificate.
+func (r *MeterBaseReconciler) servingCertServices(
+	instance *marketplacev1alpha1.MeterBase,
+	factory *manifests.Factory,
+) []*corev1.Service {
+	operatorService, _ := factory.NewPrometheusOperatorService()
+	prometheusService, _ := factory.PrometheusService(instance.Name)
+	metricStateService, _ := factory.MetricStateService()
+
+	return []*corev1.Service{
+		operatorService,
+		prometheusService,
+		metricStateService,
+		factory.KubeStateMetricsService(),
+	}
+}
+
+// reconcileServingCerts stands in for the OpenShift service CA on vanilla
+// clusters. A self-signed CA issues the serving certificates of the services
+// and is set in the CA bundles the components verify them with.
+func (r *MeterBaseReconciler) reconcileServingCerts(
+	instance *marketplacev1alpha1.MeterBase,
+	factory *manifests.Factory,
+	services ...*corev1.Service,
+) []ClientAction {
+	if !factory.IsKubernetesProfile() {
+		return nil
+	}
+
+	ca := &corev1.Secret{}
+	args := manifests.CreateOrUpdateFactoryItemArgs{
+		Owner:   instance,
+		Patcher: r.patcher,
+	}
+
+	actions := []ClientAction{
+		HandleResult(
+			GetAction(
+				types.NamespacedName{Namespace: r.cfg.DeployedNamespace, Name: manifests.ServingCASecretName},
+				ca,
+			),
+			OnNotFound(Call(func() (ClientAction, error) {
+				newCA, err := factory.ServingCASecret()
+				if err != nil {
+					return nil, err
+				}
+
+				newCA.DeepCopyInto(ca)
+				return CreateAction(ca, CreateWithAddController(instance)), nil
+			})),
+		),
+	}
+
+	for _, newBundle := range []func() (*corev1.ConfigMap, error){
+		factory.PrometheusServingCertsCABundle,
+		factory.NewPrometheusOperatorCertsCABundle,
+	} {
+		newBundle := newBundle
+		actions = append(actions, manifests.CreateOrUpdateFactoryItemAction(
+			&corev1.ConfigMap{},
+			func() (runtime.Object, error) {
+				cm, err := newBundle()
+				if err != nil {
+					return nil, err
+				}
+
+				return factory.WithServingCA(cm, ca), nil
+			},
+			args,
+		))
+	}
+
+	for _, service := range services {
+		service := service
+		secret := &corev1.Secret{}
+		key := types.NamespacedName{
+			Namespace: service.Namespace,
+			Name:      service.Annotations[manifests.ServingCertSecretAnnotation],
+		}
+
+		actions = append(actions, HandleResult(
+			GetAction(key, secret),
+			OnNotFound(Call(func() (ClientAction, error) {
+				newSecret, err := factory.ServingCertSecret(service, ca)
+				if err != nil {
+					return nil, err
+				}
+
+				return CreateAction(newSecret, CreateWithAddController(instance)), nil
+			})),
+			OnContinue(Call(func() (ClientAction, error) {
+				if !manifests.ServingCertNeedsRenewal(secret, ca, time.Now()) {
+					return nil, nil
+				}
+
+				newSecret, err := factory.ServingCertSecret(service, ca)
+				if err != nil {
+					return nil, err
+				}
+
+				r.Log.Info("renewing serving certificate", "secret", key)
+				secret.Data = newSecret.Data
+				return UpdateAction(secret), nil
+			})),
+		))
+	}
+
+	return actions
+}
+
+// installKubeStateMetrics deploys kube-state-metrics on vanilla clusters
+// where there is no cluster monitoring providing it.
+func (r *MeterBaseReconciler) installKubeStateMetrics(
+	instance *marketplacev1alpha1.MeterBase,
+	factory *manifests.Factory,
+) []ClientAction {
+	if !factory.IsKubernetesProfile() {
+		return nil
+	}
+
+	args := manifests.CreateOrUpdateFactoryItemArgs{
+		Owner:   instance,
+		Patcher: r.patcher,
+	}
+
+	return []ClientAction{
+		manifests.CreateOrUpdateFactoryItemAction(
+			&appsv1.Deployment{},
+			func() (runtime.Object, error) {
+				return factory.KubeStateMetricsDeployment(), nil
+			},
+			args,
+		),
+		manifests.CreateOrUpdateFactoryItemAction(
+			&corev1.Service{},
+			func() (runtime.Object, error) {
+				return factory.KubeStateMetricsService(), nil
+			},
+			args,
+		),
+	}
+}
+
+func (r *MeterBaseReconciler) uninstallKubeStateMetrics(
+	instance *marketplacev1alpha1.MeterBase,
+	factory *manifests.Factory,
+) []ClientAction {
+	deployment := factory.KubeStateMetricsDeployment()
+	service := factory.KubeStateMetricsService()
+
+	return []ClientAction{
+		HandleResult(
+			GetAction(types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service),
+			OnContinue(DeleteAction(service))),
+		HandleResult(
+			GetAction(types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}, deployment),
+			OnContinue(DeleteAction(deployment))),
+	}
+}
+
+// reconcilePrometheusPodDisruptionBudget keeps a replica of a highly
+// available Prometheus running during voluntary disruptions. A single
+// replica is not guarded so node drains are not blocked.
+func (r *MeterBaseReconciler) reconcilePrometheusPodDisruptionBudget(
+	instance *marketplacev1alpha1.MeterBase,
+	factory *manifests.Factory,
+) []ClientAction {
+	if !instance.IsPrometheusHA() {
+		return r.uninstallPrometheusPodDisruptionBudget(instance, factory)
+	}
+
+	return []ClientAction{
+		manifests.CreateOrUpdateFactoryItemAction(
+			&policyv1beta1.PodDisruptionBudget{},
+			func() (runtime.Object, error) {
+				return factory.NewPrometheusPodDisruptionBudget(instance), nil
+			},
+			manifests.CreateOrUpdateFactoryItemArgs{
+				Owner:   instance,
+				Patcher: r.patcher,
+			}),
+	}
+}
+
+func (r *MeterBaseReconciler) uninstallPrometheusPodDisruptionBudget(
+	instance *marketplacev1alpha1.MeterBase,
+	factory *manifests.Factory,
+) []ClientAction {
+	pdb := factory.NewPrometheusPodDisruptionBudget(instance)
+
+	return []ClientAction{
+		HandleResult(
+			GetAction(types.NamespacedName{Namespace: pdb.Namespace, Name: pdb.Name}, pdb),
+			OnContinue(DeleteAction(pdb))),
+	}
+}
+
+func (r *MeterBaseReconciler) uninstallPrometheus(
+	instance *marketplacev1alpha1.MeterBase,
+	factory *manifests.Factory,
+) []ClientAction {
+	cm0, _ := factory.PrometheusServingCertsCABundle()
+	secret0, _ := factory.PrometheusDatasources()
 	secret1, _ := factory.PrometheusProxySecret()
 	secret2, _ := factory.PrometheusHtpasswdSecret("foo")
 	secret3, _ := factory.PrometheusRBACProxySecret()
 	secrets := []*corev1.Secret{secret0, secret1, secret2, secret3}
-	prom, _ := r.newPrometheusOperator(instance, factory, nil)
+	prom := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.Name,
+			Namespace: instance.Namespace,
+		},
+	}
 	service, _ := factory.PrometheusService(instance.Name)
-	deployment, _ := factory.MetricStateDeployment()
-	service2, _ := factory.MetricStateService()
-	sm, _ := factory.MetricStateServiceMonitor()
 
 	actions := []ClientAction{
 		HandleResult(
@@ -1110,25 +1885,162 @@ func (r *MeterBaseReconciler) uninstallPrometheus(
 				OnContinue(DeleteAction(sec))))
 	}
 
+	actions = append(actions, r.uninstallPrometheusPodDisruptionBudget(instance, factory)...)
+
 	return append(actions,
-		HandleResult(
-			GetAction(types.NamespacedName{Namespace: sm.Namespace, Name: sm.Name}, sm),
-			OnContinue(DeleteAction(sm))),
-		HandleResult(
-			GetAction(types.NamespacedName{Namespace: service2.Namespace, Name: service2.Name}, deployment),
-			OnContinue(DeleteAction(service2))),
 		HandleResult(
 			GetAction(types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service),
 			OnContinue(DeleteAction(service))),
-		HandleResult(
-			GetAction(types.NamespacedName{Namespace: deployment.Namespace, Name: deployment.Name}, deployment),
-			OnContinue(DeleteAction(deployment))),
 		HandleResult(
 			GetAction(types.NamespacedName{Namespace: prom.Namespace, Name: prom.Name}, prom),
 			OnContinue(DeleteAction(prom))),
 	)
 }
 
+// checkExternalPrometheus records in the ExternalPrometheusSelected condition
+// if the external Prometheus selects the resources created for it.
+func (r *MeterBaseReconciler) checkExternalPrometheus(
+	log logr.Logger,
+	instance *marketplacev1alpha1.MeterBase,
+	factory *manifests.Factory,
+) []ClientAction {
+	return []ClientAction{
+		Call(func() (ClientAction, error) {
+			condition, err := r.externalPrometheusCondition(instance, factory)
+			if err != nil {
+				return nil, err
+			}
+
+			if !instance.Status.Conditions.SetCondition(condition) {
+				return nil, nil
+			}
+
+			log.Info("external prometheus selection changed", "reason", condition.Reason, "message", condition.Message)
+			return UpdateAction(instance, UpdateStatusOnly(true)), nil
+		}),
+	}
+}
+
+func (r *MeterBaseReconciler) externalPrometheusCondition(
+	instance *marketplacev1alpha1.MeterBase,
+	factory *manifests.Factory,
+) (status.Condition, error) {
+	condition := status.Condition{
+		Type:   marketplacev1alpha1.ConditionExternalPrometheusSelected,
+		Status: corev1.ConditionUnknown,
+		Reason: marketplacev1alpha1.ReasonExternalPrometheusUnverified,
+	}
+
+	serviceMonitor, err := factory.MetricStateServiceMonitor()
+	if err != nil {
+		return condition, err
+	}
+
+	ref := instance.Spec.ExternalPrometheusRef
+	if ref == nil {
+		condition.Message = fmt.Sprintf("set externalPrometheusRef to verify the external prometheus selects the service monitor %s/%s and the recording rules labeled %s=%s in %s",
+			serviceMonitor.Namespace, serviceMonitor.Name,
+			utils.MeteredAnnotation[0], utils.MeteredAnnotation[1], serviceMonitor.Namespace)
+		return condition, nil
+	}
+
+	prometheus := &monitoringv1.Prometheus{}
+	err = r.Client.Get(context.TODO(), types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, prometheus)
+
+	if k8serrors.IsNotFound(err) {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = marketplacev1alpha1.ReasonExternalPrometheusNotSelected
+		condition.Message = fmt.Sprintf("prometheus %s/%s not found", ref.Namespace, ref.Name)
+		return condition, nil
+	}
+
+	if err != nil {
+		return condition, err
+	}
+
+	notSelected := []string{}
+
+	selected, err := r.prometheusSelects(prometheus,
+		prometheus.Spec.ServiceMonitorSelector,
+		prometheus.Spec.ServiceMonitorNamespaceSelector,
+		serviceMonitor.Labels, serviceMonitor.Namespace)
+	if err != nil {
+		return condition, err
+	}
+
+	if !selected {
+		notSelected = append(notSelected, fmt.Sprintf("service monitor %s/%s", serviceMonitor.Namespace, serviceMonitor.Name))
+	}
+
+	// the recording rules of the meterdefinitions are created in the same
+	// namespace as the service monitor
+	selected, err = r.prometheusSelects(prometheus,
+		prometheus.Spec.RuleSelector,
+		prometheus.Spec.RuleNamespaceSelector,
+		recordingRuleLabels(), serviceMonitor.Namespace)
+	if err != nil {
+		return condition, err
+	}
+
+	if !selected {
+		notSelected = append(notSelected, fmt.Sprintf("recording rules labeled %s=%s in %s",
+			utils.MeteredAnnotation[0], utils.MeteredAnnotation[1], serviceMonitor.Namespace))
+	}
+
+	if len(notSelected) != 0 {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = marketplacev1alpha1.ReasonExternalPrometheusNotSelected
+		condition.Message = fmt.Sprintf("prometheus %s/%s does not select the %s",
+			ref.Namespace, ref.Name, strings.Join(notSelected, ", "))
+		return condition, nil
+	}
+
+	condition.Status = corev1.ConditionTrue
+	condition.Reason = marketplacev1alpha1.ReasonExternalPrometheusSelected
+	condition.Message = fmt.Sprintf("prometheus %s/%s selects the metering resources", ref.Namespace, ref.Name)
+	return condition, nil
+}
+
+// prometheusSelects returns true if the selectors of the Prometheus select an
+// object with the labels in the namespace. As in the prometheus operator, a
+// nil selector selects nothing and a nil namespace selector only selects the
+// namespace of the Prometheus.
+func (r *MeterBaseReconciler) prometheusSelects(
+	prometheus *monitoringv1.Prometheus,
+	selector, namespaceSelector *metav1.LabelSelector,
+	objLabels map[string]string,
+	namespace string,
+) (bool, error) {
+	if selector == nil {
+		return false, nil
+	}
+
+	sel, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+
+	if !sel.Matches(labels.Set(objLabels)) {
+		return false, nil
+	}
+
+	if namespaceSelector == nil {
+		return namespace == prometheus.Namespace, nil
+	}
+
+	nsSel, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+	if err != nil {
+		return false, err
+	}
+
+	ns := &corev1.Namespace{}
+	if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, err
+	}
+
+	return nsSel.Matches(labels.Set(ns.Labels)), nil
+}
+
 func (r *MeterBaseReconciler) reconcilePrometheusService(
 	instance *marketplacev1alpha1.MeterBase,
 	service *corev1.Service,
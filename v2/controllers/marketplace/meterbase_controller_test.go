@@ -15,10 +15,28 @@
 package marketplace
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+	"regexp"
 	"time"
 
+	"emperror.dev/errors"
+	"github.com/gotidy/ptr"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	marketplacev1beta1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/manifests"
+	prom "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("MeterbaseController", func() {
@@ -45,4 +63,590 @@ var _ = Describe("MeterbaseController", func() {
 			Expect(exp).To(HaveLen(3))
 		})
 	})
+
+	Describe("meter reports", func() {
+		var (
+			ctrl     *MeterBaseReconciler
+			instance *marketplacev1alpha1.MeterBase
+			start    = time.Now().UTC()
+			end      = start.AddDate(0, 0, 1)
+		)
+
+		BeforeEach(func() {
+			ctrl = &MeterBaseReconciler{}
+			instance = &marketplacev1alpha1.MeterBase{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rhm-marketplaceconfig-meterbase",
+					Namespace: "openshift-redhat-marketplace",
+				},
+			}
+		})
+
+		It("should report from the bundled prometheus", func() {
+			report := ctrl.newMeterReport(instance.Namespace, start, end, "foo", instance, promServiceName)
+
+			Expect(report.Spec.ExternalPrometheus).To(BeNil())
+			Expect(report.Spec.PrometheusService).ToNot(BeNil())
+			Expect(report.Spec.PrometheusService.Name).To(Equal(promServiceName))
+		})
+
+		It("should report from an external prometheus", func() {
+			instance.Spec.ExternalPrometheus = &common.ExternalPrometheus{
+				URL: "https://thanos-querier.openshift-monitoring.svc:9091",
+			}
+
+			report := ctrl.newMeterReport(instance.Namespace, start, end, "foo", instance, promServiceName)
+
+			Expect(report.Spec.PrometheusService).To(BeNil())
+			Expect(report.Spec.ExternalPrometheus).To(Equal(instance.Spec.ExternalPrometheus))
+		})
+
+		It("should report gaps from the remote write store", func() {
+			instance.Spec.Prometheus = &marketplacev1alpha1.PrometheusSpec{
+				RemoteWrite: &marketplacev1alpha1.PrometheusRemoteWriteSpec{
+					URL: "https://thanos-receive.example.com/api/v1/receive",
+					Query: &common.ExternalPrometheus{
+						URL: "https://thanos-query.example.com",
+					},
+				},
+			}
+
+			report := ctrl.newMeterReport(instance.Namespace, start, end, "foo", instance, promServiceName)
+
+			Expect(report.Spec.PrometheusService).ToNot(BeNil())
+			Expect(report.Spec.RemoteStore).To(Equal(instance.Spec.Prometheus.RemoteWrite.Query))
+		})
+	})
+
+	Describe("external prometheus", func() {
+		var (
+			ctrl           *MeterBaseReconciler
+			factory        *manifests.Factory
+			instance       *marketplacev1alpha1.MeterBase
+			prometheus     *monitoringv1.Prometheus
+			serviceMonitor *monitoringv1.ServiceMonitor
+		)
+
+		BeforeEach(func() {
+			cfg, err := config.GetConfig()
+			Expect(err).To(Succeed())
+
+			factory = manifests.NewFactory(cfg, scheme.Scheme)
+			serviceMonitor, err = factory.MetricStateServiceMonitor()
+			Expect(err).To(Succeed())
+
+			instance = &marketplacev1alpha1.MeterBase{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rhm-marketplaceconfig-meterbase",
+					Namespace: serviceMonitor.Namespace,
+				},
+				Spec: marketplacev1alpha1.MeterBaseSpec{
+					Enabled: true,
+					ExternalPrometheus: &common.ExternalPrometheus{
+						URL: "https://thanos-querier.openshift-monitoring.svc:9091",
+					},
+					ExternalPrometheusRef: &common.NamespacedNameReference{
+						Name:      "k8s",
+						Namespace: "monitoring",
+					},
+				},
+			}
+			prometheus = &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "k8s",
+					Namespace: "monitoring",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					ServiceMonitorSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app.kubernetes.io/name": "rhm-metric-state",
+						},
+					},
+					ServiceMonitorNamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"openshift.io/cluster-monitoring": "true",
+						},
+					},
+					RuleSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"marketplace.redhat.com/metering": "true",
+						},
+					},
+					RuleNamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"openshift.io/cluster-monitoring": "true",
+						},
+					},
+				},
+			}
+		})
+
+		reconciler := func(objs ...runtime.Object) *MeterBaseReconciler {
+			s := runtime.NewScheme()
+			Expect(scheme.AddToScheme(s)).To(Succeed())
+			Expect(monitoringv1.AddToScheme(s)).To(Succeed())
+
+			return &MeterBaseReconciler{
+				Client: fake.NewFakeClientWithScheme(s, objs...),
+			}
+		}
+
+		namespace := func(labels map[string]string) *corev1.Namespace {
+			return &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   serviceMonitor.Namespace,
+					Labels: labels,
+				},
+			}
+		}
+
+		It("should be selected by the external prometheus", func() {
+			ctrl = reconciler(prometheus, namespace(map[string]string{
+				"openshift.io/cluster-monitoring": "true",
+			}))
+
+			condition, err := ctrl.externalPrometheusCondition(instance, factory)
+			Expect(err).To(Succeed())
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(marketplacev1alpha1.ReasonExternalPrometheusSelected))
+		})
+
+		It("should report a namespace the external prometheus does not select", func() {
+			ctrl = reconciler(prometheus, namespace(nil))
+
+			condition, err := ctrl.externalPrometheusCondition(instance, factory)
+			Expect(err).To(Succeed())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(marketplacev1alpha1.ReasonExternalPrometheusNotSelected))
+			Expect(condition.Message).To(ContainSubstring(serviceMonitor.Name))
+		})
+
+		It("should report recording rules the external prometheus does not select", func() {
+			prometheus.Spec.RuleSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"role": "alert-rules",
+				},
+			}
+			ctrl = reconciler(prometheus, namespace(map[string]string{
+				"openshift.io/cluster-monitoring": "true",
+			}))
+
+			condition, err := ctrl.externalPrometheusCondition(instance, factory)
+			Expect(err).To(Succeed())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Message).To(ContainSubstring("recording rules"))
+			Expect(condition.Message).ToNot(ContainSubstring(serviceMonitor.Name))
+		})
+
+		It("should only select its own namespace without a namespace selector", func() {
+			prometheus.Spec.ServiceMonitorNamespaceSelector = nil
+			prometheus.Spec.RuleNamespaceSelector = nil
+			ctrl = reconciler(prometheus, namespace(nil))
+
+			condition, err := ctrl.externalPrometheusCondition(instance, factory)
+			Expect(err).To(Succeed())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+
+			prometheus.Namespace = serviceMonitor.Namespace
+			instance.Spec.ExternalPrometheusRef.Namespace = serviceMonitor.Namespace
+			ctrl = reconciler(prometheus, namespace(nil))
+
+			condition, err = ctrl.externalPrometheusCondition(instance, factory)
+			Expect(err).To(Succeed())
+			Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		})
+
+		It("should not select anything without a selector", func() {
+			prometheus.Spec.ServiceMonitorSelector = nil
+			ctrl = reconciler(prometheus, namespace(map[string]string{
+				"openshift.io/cluster-monitoring": "true",
+			}))
+
+			condition, err := ctrl.externalPrometheusCondition(instance, factory)
+			Expect(err).To(Succeed())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		})
+
+		It("should report what to select when the prometheus is unknown", func() {
+			instance.Spec.ExternalPrometheusRef = nil
+			ctrl = reconciler()
+
+			condition, err := ctrl.externalPrometheusCondition(instance, factory)
+			Expect(err).To(Succeed())
+			Expect(condition.Status).To(Equal(corev1.ConditionUnknown))
+			Expect(condition.Reason).To(Equal(marketplacev1alpha1.ReasonExternalPrometheusUnverified))
+			Expect(condition.Message).To(ContainSubstring(serviceMonitor.Name))
+		})
+	})
+
+	Describe("storage size", func() {
+		var (
+			storage  *marketplacev1alpha1.StorageSpec
+			required = resource.MustParse("30Gi")
+			month    = 30 * 24 * time.Hour
+		)
+
+		BeforeEach(func() {
+			storage = &marketplacev1alpha1.StorageSpec{
+				Size: resource.MustParse("20Gi"),
+			}
+		})
+
+		It("should flag a retention shorter than the report backlog", func() {
+			cond := storageSizeCondition(storage, 15*24*time.Hour, resource.MustParse("40Gi"), required)
+
+			Expect(cond.Status).To(Equal(corev1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(marketplacev1alpha1.ReasonRetentionBelowBacklog))
+		})
+
+		It("should flag an under-sized pvc", func() {
+			cond := storageSizeCondition(storage, month, resource.MustParse("20Gi"), required)
+
+			Expect(cond.Status).To(Equal(corev1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(marketplacev1alpha1.ReasonStorageBelowEstimate))
+
+			storage.AutoExpand = true
+			cond = storageSizeCondition(storage, month, resource.MustParse("20Gi"), required)
+
+			Expect(cond.Status).To(Equal(corev1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(marketplacev1alpha1.ReasonStorageExpanding))
+		})
+
+		It("should accept a pvc covering the estimate", func() {
+			cond := storageSizeCondition(storage, month, resource.MustParse("40Gi"), required)
+
+			Expect(cond.Status).To(Equal(corev1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(marketplacev1alpha1.ReasonStorageSufficient))
+		})
+
+		It("should round expanded storage up to Gi", func() {
+			size := expandedStorageSize(resource.MustParse("2049Mi"))
+			Expect(size.String()).To(Equal("3Gi"))
+
+			size = expandedStorageSize(resource.MustParse("30Gi"))
+			Expect(size.String()).To(Equal("30Gi"))
+		})
+	})
+
+	Describe("metric allowlist", func() {
+		It("should only allow the metrics of active meter definitions", func() {
+			mdef := &marketplacev1beta1.MeterDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "foons"},
+				Spec: marketplacev1beta1.MeterDefinitionSpec{
+					Meters: []marketplacev1beta1.MeterWorkload{
+						{Query: "kube_pod_container_resource_requests{resource=\"cpu\"}"},
+						{Query: "sum(rate(container_cpu_usage_seconds_total[5m]))"},
+					},
+				},
+			}
+
+			names, err := meteredMetricNames(mdef)
+			Expect(err).To(Succeed())
+			Expect(names).To(Equal([]string{
+				"container_cpu_usage_seconds_total",
+				"kube_pod_container_resource_requests",
+			}))
+
+			now := metav1.Now()
+			mdef.DeletionTimestamp = &now
+			Expect(meteredMetricNames(mdef)).To(BeEmpty())
+		})
+
+		It("should keep every metric when the metric names are unknown", func() {
+			mdef := &marketplacev1beta1.MeterDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "foons"},
+				Spec: marketplacev1beta1.MeterDefinitionSpec{
+					Meters: []marketplacev1beta1.MeterWorkload{
+						{Query: `{__name__=~"kube_pod_container_resource_(requests|limits)"}`},
+					},
+				},
+			}
+
+			_, err := meteredMetricNames(mdef)
+			Expect(errors.Is(err, prom.UndeterminedMetricNames)).To(BeTrue())
+
+			condition := metricAllowlistCondition(err)
+			Expect(condition.IsTrue()).To(BeTrue())
+			Expect(condition.Message).To(ContainSubstring("kube_pod_container_resource_(requests|limits)"))
+			Expect(metricAllowlistCondition(nil).IsFalse()).To(BeTrue())
+		})
+	})
+
+	Describe("high availability", func() {
+		var (
+			factory   *manifests.Factory
+			meterbase *marketplacev1alpha1.MeterBase
+		)
+
+		BeforeEach(func() {
+			cfg, err := config.GetConfig()
+			Expect(err).To(Succeed())
+
+			factory = manifests.NewFactory(cfg, scheme.Scheme)
+			meterbase = &marketplacev1alpha1.MeterBase{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rhm-marketplaceconfig-meterbase",
+					Namespace: "openshift-redhat-marketplace",
+				},
+				Spec: marketplacev1alpha1.MeterBaseSpec{
+					Enabled: true,
+					Prometheus: &marketplacev1alpha1.PrometheusSpec{
+						Replicas: ptr.Int32(2),
+						Storage: marketplacev1alpha1.StorageSpec{
+							Size: resource.MustParse("30Gi"),
+						},
+					},
+				},
+			}
+		})
+
+		It("should spread labeled replicas across nodes", func() {
+			Expect(meterbase.IsPrometheusHA()).To(BeTrue())
+
+			p, err := factory.NewPrometheusDeployment(meterbase, nil)
+			Expect(err).To(Succeed())
+
+			Expect(*p.Spec.Replicas).To(Equal(int32(2)))
+			Expect(*p.Spec.ReplicaExternalLabelName).To(Equal(prom.ReplicaExternalLabelName))
+
+			terms := p.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+			Expect(terms).To(HaveLen(1))
+			Expect(terms[0].PodAffinityTerm.LabelSelector.MatchLabels).To(HaveKeyWithValue("prometheus", meterbase.Name))
+			Expect(terms[0].PodAffinityTerm.TopologyKey).To(Equal(corev1.LabelHostname))
+		})
+
+		It("should keep a replica available", func() {
+			pdb := factory.NewPrometheusPodDisruptionBudget(meterbase)
+
+			Expect(pdb.Name).To(Equal(meterbase.Name))
+			Expect(pdb.Spec.MinAvailable.IntValue()).To(Equal(1))
+			Expect(pdb.Spec.Selector.MatchLabels).To(HaveKeyWithValue("prometheus", meterbase.Name))
+		})
+
+		It("should not guard a single replica", func() {
+			meterbase.Spec.Prometheus.Replicas = ptr.Int32(1)
+			Expect(meterbase.IsPrometheusHA()).To(BeFalse())
+
+			meterbase.Spec.Prometheus = nil
+			Expect(meterbase.IsPrometheusHA()).To(BeFalse())
+		})
+	})
+
+	Describe("resource recommendations", func() {
+		var (
+			factory   *manifests.Factory
+			meterbase *marketplacev1alpha1.MeterBase
+		)
+
+		recommended := func(cpu, memory string) corev1.ResourceRequirements {
+			return corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			cfg, err := config.GetConfig()
+			Expect(err).To(Succeed())
+
+			factory = manifests.NewFactory(cfg, scheme.Scheme)
+			meterbase = &marketplacev1alpha1.MeterBase{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rhm-marketplaceconfig-meterbase",
+					Namespace: "openshift-redhat-marketplace",
+				},
+				Spec: marketplacev1alpha1.MeterBaseSpec{
+					Enabled: true,
+					Prometheus: &marketplacev1alpha1.PrometheusSpec{
+						Storage: marketplacev1alpha1.StorageSpec{
+							Size: resource.MustParse("30Gi"),
+						},
+					},
+				},
+				Status: marketplacev1alpha1.MeterBaseStatus{
+					Recommendations: &marketplacev1alpha1.ResourceRecommendations{
+						LastUpdateTime: metav1.Now(),
+						Containers: []marketplacev1alpha1.ContainerResourceRecommendation{
+							{Component: "prometheus", Container: "prometheus", Resources: recommended("200m", "2Gi")},
+							{Component: "prometheus", Container: "kube-rbac-proxy", Resources: recommended("15m", "30Mi")},
+							{Component: "metric-state", Container: "metric-state", Resources: recommended("50m", "80Mi")},
+						},
+					},
+				},
+			}
+		})
+
+		It("should only apply the recommendations when enabled", func() {
+			p, err := factory.NewPrometheusDeployment(meterbase, nil)
+			Expect(err).To(Succeed())
+			Expect(p.Spec.Resources.Requests.Cpu().String()).To(Equal("70m"))
+
+			meterbase.Spec.AutoApplyRecommendations = true
+			p, err = factory.NewPrometheusDeployment(meterbase, nil)
+			Expect(err).To(Succeed())
+			Expect(p.Spec.Resources).To(Equal(recommended("200m", "2Gi")))
+
+			for _, container := range p.Spec.Containers {
+				if container.Name == "kube-rbac-proxy" {
+					Expect(container.Resources).To(Equal(recommended("15m", "30Mi")))
+				}
+			}
+
+			deployment, err := factory.MetricStateDeployment()
+			Expect(err).To(Succeed())
+			manifests.SetRecommendedResources(meterbase, marketplacev1alpha1.RecommendationComponentMetricState, deployment.Spec.Template.Spec.Containers)
+			Expect(deployment.Spec.Template.Spec.Containers[0].Resources).To(Equal(recommended("50m", "80Mi")))
+		})
+
+		It("should prefer the resources of the meterbase", func() {
+			meterbase.Spec.AutoApplyRecommendations = true
+			meterbase.Spec.Prometheus.ResourceRequirements = recommended("1", "4Gi")
+
+			p, err := factory.NewPrometheusDeployment(meterbase, nil)
+			Expect(err).To(Succeed())
+			Expect(p.Spec.Resources).To(Equal(recommended("1", "4Gi")))
+		})
+
+		It("should read the usage of the metering pods", func() {
+			prometheus := &monitoringv1.Prometheus{ObjectMeta: metav1.ObjectMeta{Name: meterbase.Name}}
+			components := recommendationComponents(prometheus)
+
+			Expect(components).To(HaveLen(3))
+			Expect(regexp.MustCompile("^(?:" + components[0].podRegex + ")$").MatchString("prometheus-rhm-marketplaceconfig-meterbase-1")).To(BeTrue())
+			Expect(regexp.MustCompile("^(?:" + components[1].podRegex + ")$").MatchString("rhm-metric-state-7d9f8b6c4-x2x8z")).To(BeTrue())
+			Expect(regexp.MustCompile("^(?:" + components[2].podRegex + ")$").MatchString("meter-report-2021-03-01-abcde")).To(BeTrue())
+		})
+
+		It("should refresh the recommendations hourly", func() {
+			now := time.Now()
+			Expect(recommendationsDue(nil, now)).To(BeTrue())
+			Expect(recommendationsDue(meterbase.Status.Recommendations, now)).To(BeFalse())
+			Expect(recommendationsDue(meterbase.Status.Recommendations, now.Add(recommendationInterval))).To(BeTrue())
+		})
+	})
+
+	Describe("kubernetes profile", func() {
+		var (
+			openshiftFactory *manifests.Factory
+			factory          *manifests.Factory
+			meterbase        *marketplacev1alpha1.MeterBase
+		)
+
+		BeforeEach(func() {
+			cfg, err := config.GetConfig()
+			Expect(err).To(Succeed())
+
+			kubernetesCfg := *cfg
+			kubernetesCfg.Profile = config.InstallProfileKubernetes
+
+			openshiftFactory = manifests.NewFactory(cfg, scheme.Scheme)
+			factory = manifests.NewFactory(&kubernetesCfg, scheme.Scheme)
+			meterbase = &marketplacev1alpha1.MeterBase{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "rhm-marketplaceconfig-meterbase",
+					Namespace: "openshift-redhat-marketplace",
+				},
+				Spec: marketplacev1alpha1.MeterBaseSpec{
+					Enabled: true,
+					Prometheus: &marketplacev1alpha1.PrometheusSpec{
+						Storage: marketplacev1alpha1.StorageSpec{
+							Size: resource.MustParse("30Gi"),
+						},
+					},
+				},
+			}
+		})
+
+		containerNames := func(containers []corev1.Container) []string {
+			names := []string{}
+			for _, c := range containers {
+				names = append(names, c.Name)
+			}
+			return names
+		}
+
+		It("should authenticate with kube-rbac-proxy only", func() {
+			Expect(openshiftFactory.IsKubernetesProfile()).To(BeFalse())
+			Expect(factory.IsKubernetesProfile()).To(BeTrue())
+
+			p, err := openshiftFactory.NewPrometheusDeployment(meterbase, nil)
+			Expect(err).To(Succeed())
+			Expect(containerNames(p.Spec.Containers)).To(ContainElement("prometheus-proxy"))
+
+			p, err = factory.NewPrometheusDeployment(meterbase, nil)
+			Expect(err).To(Succeed())
+			Expect(containerNames(p.Spec.Containers)).To(ConsistOf("authcheck", "kube-rbac-proxy-1"))
+			Expect(p.Spec.Secrets).To(ConsistOf("kube-rbac-proxy", "rhm-prometheus-meterbase-tls"))
+			Expect(p.Spec.ConfigMaps).To(ConsistOf("serving-certs-ca-bundle"))
+
+			service, err := factory.PrometheusService(meterbase.Name)
+			Expect(err).To(Succeed())
+			Expect(service.Spec.Ports).To(HaveLen(1))
+			Expect(service.Spec.Ports[0].Name).To(Equal("rbac"))
+		})
+
+		It("should issue serving certificates", func() {
+			ca, err := factory.ServingCASecret()
+			Expect(err).To(Succeed())
+
+			service, err := factory.PrometheusService(meterbase.Name)
+			Expect(err).To(Succeed())
+
+			secret, err := factory.ServingCertSecret(service, ca)
+			Expect(err).To(Succeed())
+			Expect(secret.Name).To(Equal("rhm-prometheus-meterbase-tls"))
+			Expect(secret.Type).To(Equal(corev1.SecretTypeTLS))
+
+			bundle, err := factory.PrometheusServingCertsCABundle()
+			Expect(err).To(Succeed())
+			bundle = factory.WithServingCA(bundle, ca)
+			Expect(bundle.Annotations).ToNot(HaveKey(manifests.InjectCABundleAnnotation))
+
+			roots := x509.NewCertPool()
+			Expect(roots.AppendCertsFromPEM([]byte(bundle.Data[manifests.ServingCABundleKey]))).To(BeTrue())
+
+			block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).To(Succeed())
+
+			_, err = cert.Verify(x509.VerifyOptions{
+				DNSName: "rhm-prometheus-meterbase." + service.Namespace + ".svc",
+				Roots:   roots,
+			})
+			Expect(err).To(Succeed())
+
+			Expect(manifests.ServingCertNeedsRenewal(secret, ca, time.Now())).To(BeFalse())
+			Expect(manifests.ServingCertNeedsRenewal(secret, ca, time.Now().AddDate(2, 0, 0))).To(BeTrue())
+
+			otherCA, err := factory.ServingCASecret()
+			Expect(err).To(Succeed())
+			Expect(manifests.ServingCertNeedsRenewal(secret, otherCA, time.Now())).To(BeTrue())
+		})
+
+		It("should scrape the kubelets and its own kube-state-metrics", func() {
+			deployment := factory.KubeStateMetricsDeployment()
+			Expect(containerNames(deployment.Spec.Template.Spec.Containers)).To(ConsistOf(
+				"kube-state-metrics", "kube-rbac-proxy-main", "kube-rbac-proxy-self"))
+			Expect(deployment.Spec.Template.Spec.ServiceAccountName).To(Equal(manifests.KubeStateMetricsServiceAccount))
+
+			service := factory.KubeStateMetricsService()
+			Expect(service.Annotations).To(HaveKeyWithValue(manifests.ServingCertSecretAnnotation, manifests.KubeStateMetricsTLSSecret))
+
+			kubeState, err := factory.KubeStateMetricsServiceMonitor()
+			Expect(err).To(Succeed())
+			Expect(kubeState.Spec.Selector.MatchLabels).To(Equal(map[string]string{"k8s-app": "kube-state-metrics"}))
+			Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("k8s-app", "kube-state-metrics"))
+
+			for _, ep := range kubeState.Spec.Endpoints {
+				Expect(ep.TLSConfig.ServerName).To(Equal(manifests.KubeStateMetricsName + "." + kubeState.Namespace + ".svc"))
+			}
+
+			kubelet := factory.KubeletServiceMonitor()
+			Expect(kubelet.Spec.NamespaceSelector.MatchNames).To(Equal([]string{"kube-system"}))
+			Expect(kubelet.Spec.Endpoints).To(HaveLen(2))
+			Expect(kubelet.Spec.Endpoints[1].Path).To(Equal("/metrics/cadvisor"))
+		})
+	})
 })
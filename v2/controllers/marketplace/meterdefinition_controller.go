@@ -24,12 +24,15 @@ import (
 	"emperror.dev/errors"
 	"github.com/go-logr/logr"
 	"github.com/gotidy/ptr"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/log"
 	"github.com/prometheus/common/model"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	rhmclient "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/client"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
 	prom "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/prometheus"
 	mktypes "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/types"
@@ -38,6 +41,8 @@ import (
 	. "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/reconcileutils"
 	status "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/status"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
@@ -52,6 +57,10 @@ import (
 
 const meterDefinitionFinalizer = "meterdefinition.finalizer.marketplace.redhat.com"
 
+// orphanGracePeriod is how long the installing CSV has to be missing before the
+// meterdefinition is deleted.
+const orphanGracePeriod = 15 * time.Minute
+
 const (
 	MeteredResourceAnnotationKey = "marketplace.redhat.com/meteredUIDs"
 )
@@ -96,10 +105,24 @@ func (m *MeterDefinitionReconciler) InjectOperatorConfig(cfg *config.OperatorCon
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
 func (r *MeterDefinitionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := rhmclient.AddMeterDefinitionTemplateRefIndex(mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
+
+	templateMapFn := handler.ToRequestsFunc(
+		func(a handler.MapObject) []reconcile.Request {
+			return r.requestsForTemplate(a.Meta.GetName())
+		})
+
 	// Create a new controller
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1beta1.MeterDefinition{}).
 		Watches(&source.Kind{Type: &v1beta1.MeterDefinition{}}, &handler.EnqueueRequestForObject{}).
+		Watches(
+			&source.Kind{Type: &v1beta1.MeterDefinitionTemplate{}},
+			&handler.EnqueueRequestsFromMapFunc{
+				ToRequests: templateMapFn,
+			}).
 		WithOptions(controller.Options{
 			RateLimiter: workqueue.NewMaxOfRateLimiter(
 				workqueue.DefaultControllerRateLimiter(),
@@ -143,7 +166,55 @@ func (r *MeterDefinitionReconciler) Reconcile(request reconcile.Request) (reconc
 
 	reqLogger.Info("Found instance", "instance", instance.Name)
 
-	var update, requeue bool
+	if instance.GetDeletionTimestamp() != nil {
+		if !utils.Contains(instance.GetFinalizers(), meterDefinitionFinalizer) {
+			return reconcile.Result{}, nil
+		}
+
+		return r.finalizeMeterDefinition(instance)
+	}
+
+	if !utils.Contains(instance.GetFinalizers(), meterDefinitionFinalizer) {
+		if err := r.addFinalizer(instance); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	orphaned, update, err := r.isOrphaned(cc, instance)
+	if err != nil {
+		reqLogger.Error(err, "failed to check the installing csv")
+		return reconcile.Result{}, err
+	}
+
+	if orphaned {
+		reqLogger.Info("installing csv no longer exists, deleting orphaned meterdefinition",
+			"csv", instance.Spec.InstalledBy.Name, "csvNamespace", instance.Spec.InstalledBy.Namespace)
+
+		if result, _ := cc.Do(context.TODO(), DeleteAction(instance)); result.Is(Error) {
+			reqLogger.Error(result.GetError(), "Failed to delete orphaned MeterDef.")
+			return result.Return()
+		}
+
+		return reconcile.Result{}, nil
+	}
+
+	var requeue bool
+
+	if instance.Spec.TemplateRef != nil {
+		err := r.renderTemplate(cc, instance, reqLogger)
+		if err != nil {
+			reqLogger.Error(err, "failed to render meterdefinition template")
+			update = instance.Status.Conditions.SetCondition(status.Condition{
+				Type:    v1beta1.MeterDefTemplateRenderError,
+				Reason:  "RenderError",
+				Status:  corev1.ConditionTrue,
+				Message: err.Error(),
+			}) || update
+			requeue = true
+		} else {
+			update = instance.Status.Conditions.RemoveCondition(v1beta1.MeterDefTemplateRenderError) || update
+		}
+	}
 	// Set the Status Condition of signature signing
 	if instance.IsSigned() {
 		// Check the signature again, even though the admission webhook should have
@@ -175,7 +246,17 @@ func (r *MeterDefinitionReconciler) Reconcile(request reconcile.Request) (reconc
 	}
 
 	queryPreviewResult, err := r.queryPreview(cc, instance, request, reqLogger)
-	if err != nil {
+	switch {
+	case errors.Is(err, prom.QueryLimitExceeded):
+		// the query is too expensive to run, flag it until a preview runs within the limits
+		reqLogger.Info("meterdefinition query exceeds limits", "reason", err.Error())
+		update = instance.Status.Conditions.SetCondition(status.Condition{
+			Type:    v1beta1.MeterDefQueryLimitExceeded,
+			Reason:  "QueryLimitExceeded",
+			Status:  corev1.ConditionTrue,
+			Message: err.Error(),
+		}) || update
+	case err != nil:
 		update = update || instance.Status.Conditions.SetCondition(status.Condition{
 			Type:    v1beta1.MeterDefQueryPreviewSetupError,
 			Reason:  "PreviewError",
@@ -183,6 +264,15 @@ func (r *MeterDefinitionReconciler) Reconcile(request reconcile.Request) (reconc
 			Message: err.Error(),
 		})
 		requeue = true
+	default:
+		update = instance.Status.Conditions.RemoveCondition(v1beta1.MeterDefQueryLimitExceeded) || update
+	}
+
+	update = r.checkScrapeTargets(cc, instance, reqLogger) || update
+
+	if err := r.reconcileRecordingRules(cc, instance); err != nil {
+		reqLogger.Error(err, "failed to reconcile recording rules")
+		requeue = true
 	}
 
 	if err == nil && len(queryPreviewResult) != 0 {
@@ -215,35 +305,419 @@ func (r *MeterDefinitionReconciler) Reconcile(request reconcile.Request) (reconc
 	return reconcile.Result{RequeueAfter: r.cfg.ControllerValues.MeterDefControllerRequeueRate}, nil
 }
 
-func (r *MeterDefinitionReconciler) finalizeMeterDefinition(req *v1beta1.MeterDefinition) (reconcile.Result, error) {
-	var err error
+// renderTemplate renders the referenced MeterDefinitionTemplate and updates the
+// spec if the template output changed. The template status is set on the instance.
+func (r *MeterDefinitionReconciler) renderTemplate(cc ClientCommandRunner, instance *v1beta1.MeterDefinition, reqLogger logr.Logger) error {
+	tmpl := &v1beta1.MeterDefinitionTemplate{}
+	name := types.NamespacedName{Name: instance.Spec.TemplateRef.Name}
 
-	// TODO: add finalizers
+	if result, _ := cc.Do(context.TODO(), GetAction(name, tmpl)); !result.Is(Continue) {
+		if result.Is(NotFound) {
+			return errors.NewWithDetails("meterdefinition template not found", "template", name.Name)
+		}
+		return errors.WrapWithDetails(result, "failed to get meterdefinition template", "template", name.Name)
+	}
 
-	req.SetFinalizers(utils.RemoveKey(req.GetFinalizers(), meterDefinitionFinalizer))
-	err = r.Client.Update(context.TODO(), req)
+	spec, templateStatus, err := tmpl.Render(instance)
 	if err != nil {
+		return err
+	}
+
+	if !equality.Semantic.DeepEqual(spec, &instance.Spec) {
+		reqLogger.Info("rendered template changed, updating spec", "template", templateStatus.Name, "version", templateStatus.Version)
+		instance.Spec = *spec
+
+		if result, _ := cc.Do(context.TODO(), UpdateAction(instance)); result.Is(Error) {
+			return errors.Wrap(result.GetError(), "failed to update rendered spec")
+		}
+	}
+
+	instance.Status.Template = templateStatus
+	return nil
+}
+
+// requestsForTemplate returns a request for every MeterDefinition referencing the template.
+func (r *MeterDefinitionReconciler) requestsForTemplate(templateName string) []reconcile.Request {
+	meterdefs := &v1beta1.MeterDefinitionList{}
+
+	if err := r.Client.List(context.TODO(), meterdefs,
+		client.MatchingFields{rhmclient.IndexMeterDefinitionTemplateRef: templateName}); err != nil {
+		r.Log.Error(err, "failed to list meterdefinitions", "template", templateName)
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for _, meterdef := range meterdefs.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: meterdef.Name, Namespace: meterdef.Namespace},
+		})
+	}
+
+	return requests
+}
+
+// finalizeMeterDefinition removes the metered label from the ServiceMonitors
+// the MeterDefinition matched and then removes the finalizer.
+func (r *MeterDefinitionReconciler) finalizeMeterDefinition(instance *v1beta1.MeterDefinition) (reconcile.Result, error) {
+	reqLogger := r.Log.WithValues("Request.Namespace", instance.Namespace, "Request.Name", instance.Name)
+	reqLogger.Info("finalizing meterdefinition")
+
+	if err := r.unlabelServiceMonitors(instance); err != nil {
+		reqLogger.Error(err, "failed to remove metered label from servicemonitors")
+		return reconcile.Result{}, err
+	}
+
+	if err := r.deleteRecordingRules(r.cc, instance); err != nil {
+		reqLogger.Error(err, "failed to delete recording rules")
 		return reconcile.Result{}, err
 	}
+
+	instance.SetFinalizers(utils.RemoveKey(instance.GetFinalizers(), meterDefinitionFinalizer))
+
+	if result, _ := r.cc.Do(context.TODO(), UpdateAction(instance)); result.Is(Error) {
+		reqLogger.Error(result.GetError(), "Failed to remove finalizer.")
+		return result.Return()
+	}
+
 	return reconcile.Result{}, nil
 }
 
 // addFinalizer adds finalizers to the MeterDefinition CR
 func (r *MeterDefinitionReconciler) addFinalizer(instance *v1beta1.MeterDefinition) error {
-	r.Log.Info("Adding Finalizer to %s/%s", instance.Name, instance.Namespace)
+	r.Log.Info("Adding Finalizer", "name", instance.Name, "namespace", instance.Namespace)
 	instance.SetFinalizers(append(instance.GetFinalizers(), meterDefinitionFinalizer))
 
-	err := r.Client.Update(context.TODO(), instance)
+	if result, _ := r.cc.Do(context.TODO(), UpdateAction(instance)); result.Is(Error) {
+		r.Log.Error(result.GetError(), "Failed to update MeterDefinition with the Finalizer", "name", instance.Name, "namespace", instance.Namespace)
+		return result.GetError()
+	}
+
+	return nil
+}
+
+// unlabelServiceMonitors removes the metered label from ServiceMonitors owned by
+// services the MeterDefinition matched, unless another MeterDefinition still
+// matches the service.
+func (r *MeterDefinitionReconciler) unlabelServiceMonitors(instance *v1beta1.MeterDefinition) error {
+	services := map[types.UID]string{}
+
+	for _, resource := range instance.Status.WorkloadResources {
+		if resource.GroupVersionKind == nil || resource.GroupVersionKind.Kind != "Service" {
+			continue
+		}
+
+		services[resource.UID] = resource.Namespace
+	}
+
+	if len(services) == 0 {
+		return nil
+	}
+
+	meterdefs := &v1beta1.MeterDefinitionList{}
+	if result, _ := r.cc.Do(context.TODO(), ListAction(meterdefs)); result.Is(Error) {
+		return errors.Wrap(result.GetError(), "failed to list meterdefinitions")
+	}
+
+	for _, meterdef := range meterdefs.Items {
+		if meterdef.UID == instance.UID || meterdef.GetDeletionTimestamp() != nil {
+			continue
+		}
+
+		for _, resource := range meterdef.Status.WorkloadResources {
+			delete(services, resource.UID)
+		}
+	}
+
+	namespaces := map[string]bool{}
+	for _, namespace := range services {
+		namespaces[namespace] = true
+	}
+
+	for namespace := range namespaces {
+		serviceMonitors := &monitoringv1.ServiceMonitorList{}
+
+		if result, _ := r.cc.Do(context.TODO(), ListAction(serviceMonitors, client.InNamespace(namespace))); result.Is(Error) {
+			return errors.WrapWithDetails(result.GetError(), "failed to list servicemonitors", "namespace", namespace)
+		}
+
+		for _, sm := range serviceMonitors.Items {
+			if !utils.HasMapKey(sm.Labels, utils.MeteredAnnotation) || !isOwnedByAny(sm.GetOwnerReferences(), services) {
+				continue
+			}
+
+			utils.RemoveMapKey(sm.Labels, utils.MeteredAnnotation)
+
+			r.Log.Info("removing metered label from servicemonitor", "name", sm.Name, "namespace", sm.Namespace)
+			if result, _ := r.cc.Do(context.TODO(), UpdateAction(sm)); result.Is(Error) {
+				return errors.WrapWithDetails(result.GetError(), "failed to update servicemonitor", "name", sm.Name, "namespace", sm.Namespace)
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordingRulesKey is the PrometheusRule holding the recording rules of the
+// MeterDefinition. Rules are kept in the deployed namespace to be selected by
+// the metering Prometheus.
+func (r *MeterDefinitionReconciler) recordingRulesKey(instance *v1beta1.MeterDefinition) types.NamespacedName {
+	return types.NamespacedName{
+		Name:      fmt.Sprintf("rhm-meterdef-%s", instance.UID),
+		Namespace: r.cfg.DeployedNamespace,
+	}
+}
+
+// recordingRuleLabels are the labels a Prometheus selects the recording rules by.
+func recordingRuleLabels() map[string]string {
+	return map[string]string{
+		utils.MeteredAnnotation[0]: utils.MeteredAnnotation[1],
+	}
+}
+
+func (r *MeterDefinitionReconciler) newRecordingRules(instance *v1beta1.MeterDefinition) (*monitoringv1.PrometheusRule, error) {
+	key := r.recordingRulesKey(instance)
+	groups := []monitoringv1.RuleGroup{}
+
+	for _, meterWorkload := range instance.ToPrometheusLabels() {
+		query := prom.PromQueryFromLabels(meterWorkload, time.Time{}, time.Time{})
+		group, err := query.RecordingRuleGroup()
+
+		if err != nil {
+			return nil, errors.WrapWithDetails(err, "failed to create recording rule", "metric", meterWorkload.Metric)
+		}
+
+		groups = append(groups, group)
+	}
+
+	labels := recordingRuleLabels()
+	labels["marketplace.redhat.com/meterdef"] = instance.Name
+	labels["marketplace.redhat.com/meterdef-ns"] = instance.Namespace
+
+	return &monitoringv1.PrometheusRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Labels:    labels,
+		},
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: groups,
+		},
+	}, nil
+}
+
+// reconcileRecordingRules keeps the recording rules in sync with the meters of
+// the MeterDefinition. Meters over the query limits are not recorded.
+func (r *MeterDefinitionReconciler) reconcileRecordingRules(cc ClientCommandRunner, instance *v1beta1.MeterDefinition) error {
+	if len(instance.Spec.Meters) == 0 ||
+		instance.Status.Conditions.IsTrueFor(v1beta1.MeterDefQueryLimitExceeded) {
+		return r.deleteRecordingRules(cc, instance)
+	}
+
+	expected, err := r.newRecordingRules(instance)
 	if err != nil {
-		r.Log.Error(err, "Failed to update RazeeDeployment with the Finalizer %s/%s", instance.Name, instance.Namespace)
 		return err
 	}
+
+	rule := &monitoringv1.PrometheusRule{}
+	result, _ := cc.Do(context.TODO(),
+		HandleResult(
+			GetAction(r.recordingRulesKey(instance), rule),
+			OnNotFound(CreateAction(expected)),
+			OnContinue(Call(func() (ClientAction, error) {
+				if reflect.DeepEqual(rule.Spec, expected.Spec) &&
+					reflect.DeepEqual(rule.Labels, expected.Labels) {
+					return nil, nil
+				}
+
+				rule.Labels = expected.Labels
+				rule.Spec = expected.Spec
+				return UpdateAction(rule), nil
+			})),
+		),
+	)
+
+	if result.Is(Error) {
+		return errors.Wrap(result.GetError(), "failed to update recording rules")
+	}
+
+	return nil
+}
+
+func (r *MeterDefinitionReconciler) deleteRecordingRules(cc ClientCommandRunner, instance *v1beta1.MeterDefinition) error {
+	rule := &monitoringv1.PrometheusRule{}
+	result, _ := cc.Do(context.TODO(),
+		HandleResult(
+			GetAction(r.recordingRulesKey(instance), rule),
+			OnContinue(DeleteAction(rule)),
+		),
+	)
+
+	if result.Is(Error) {
+		return errors.Wrap(result.GetError(), "failed to delete recording rules")
+	}
+
 	return nil
 }
 
+// isOrphaned returns true if the MeterDefinition was installed by a CSV that has been missing
+// for longer than the orphan grace period. A missing CSV is only recorded on the first reconcile,
+// so a cache that is still warming up doesn't delete the meterdefinition, and a CSV replaced by
+// an upgrade hands the meterdefinition over to the CSV that replaced it.
+func (r *MeterDefinitionReconciler) isOrphaned(cc ClientCommandRunner, instance *v1beta1.MeterDefinition) (orphaned bool, update bool, err error) {
+	if instance.Spec.InstalledBy == nil {
+		return false, false, nil
+	}
+
+	csv := &olmv1alpha1.ClusterServiceVersion{}
+	name := types.NamespacedName{
+		Name:      instance.Spec.InstalledBy.Name,
+		Namespace: instance.Spec.InstalledBy.Namespace,
+	}
+
+	result, _ := cc.Do(context.TODO(), GetAction(name, csv))
+
+	if result.Is(Error) {
+		return false, false, errors.WrapWithDetails(result.GetError(), "failed to get csv", "csv", name.String())
+	}
+
+	if !result.Is(NotFound) {
+		return false, instance.Status.Conditions.RemoveCondition(v1beta1.MeterDefInstallerNotFound), nil
+	}
+
+	replacement, err := r.findReplacingCSV(cc, name)
+	if err != nil {
+		return false, false, err
+	}
+
+	if replacement != nil {
+		instance.Spec.InstalledBy.Name = replacement.Name
+		instance.Spec.InstalledBy.UID = replacement.UID
+
+		if result, _ := cc.Do(context.TODO(), UpdateAction(instance)); result.Is(Error) {
+			return false, false, errors.Wrap(result.GetError(), "failed to update the installing csv")
+		}
+
+		return false, instance.Status.Conditions.RemoveCondition(v1beta1.MeterDefInstallerNotFound), nil
+	}
+
+	cond := instance.Status.Conditions.GetCondition(v1beta1.MeterDefInstallerNotFound)
+
+	if cond == nil || !cond.IsTrue() {
+		return false, instance.Status.Conditions.SetCondition(status.Condition{
+			Type:    v1beta1.MeterDefInstallerNotFound,
+			Reason:  "CSVNotFound",
+			Status:  corev1.ConditionTrue,
+			Message: fmt.Sprintf("installing csv %s not found", name.String()),
+		}), nil
+	}
+
+	return time.Since(cond.LastTransitionTime.Time) >= orphanGracePeriod, false, nil
+}
+
+// findReplacingCSV returns the CSV that replaces the named CSV during an upgrade.
+func (r *MeterDefinitionReconciler) findReplacingCSV(cc ClientCommandRunner, name types.NamespacedName) (*olmv1alpha1.ClusterServiceVersion, error) {
+	csvs := &olmv1alpha1.ClusterServiceVersionList{}
+
+	if result, _ := cc.Do(context.TODO(), ListAction(csvs, client.InNamespace(name.Namespace))); result.Is(Error) {
+		return nil, errors.WrapWithDetails(result.GetError(), "failed to list csvs", "namespace", name.Namespace)
+	}
+
+	for i := range csvs.Items {
+		if csvs.Items[i].Spec.Replaces == name.Name {
+			return &csvs.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func isOwnedByAny(refs []metav1.OwnerReference, uids map[types.UID]string) bool {
+	for _, ref := range refs {
+		if _, ok := uids[ref.UID]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkScrapeTargets sets the ScrapeTargetRejected condition for the scrape
+// targets the metering Prometheus won't scrape. Returns true if the status
+// changed.
+func (r *MeterDefinitionReconciler) checkScrapeTargets(cc ClientCommandRunner, instance *v1beta1.MeterDefinition, reqLogger logr.Logger) bool {
+	var rejected error
+
+	for _, target := range instance.Spec.ScrapeTargets {
+		key := types.NamespacedName{Name: target.Name, Namespace: instance.Namespace}
+
+		var (
+			obj   runtime.Object
+			check func() error
+		)
+
+		switch target.Kind {
+		case v1beta1.ScrapeTargetServiceMonitor:
+			mon := &monitoringv1.ServiceMonitor{}
+			obj, check = mon, func() error { return prom.CheckServiceMonitor(mon) }
+		case v1beta1.ScrapeTargetPodMonitor:
+			mon := &monitoringv1.PodMonitor{}
+			obj, check = mon, func() error { return prom.CheckPodMonitor(mon) }
+		default:
+			continue
+		}
+
+		if result, _ := cc.Do(context.TODO(), GetAction(key, obj)); !result.Is(Continue) {
+			if result.Is(Error) {
+				reqLogger.Error(result.GetError(), "failed to get scrape target", "kind", target.Kind, "name", target.Name)
+			}
+			continue
+		}
+
+		if err := check(); err != nil {
+			rejected = errors.Append(rejected, errors.WithMessagef(err, "%s %s", target.Kind, target.Name))
+		}
+	}
+
+	if rejected == nil {
+		return instance.Status.Conditions.RemoveCondition(v1beta1.MeterDefScrapeTargetRejected)
+	}
+
+	reqLogger.Info("scrape targets rejected", "reason", rejected.Error())
+	return instance.Status.Conditions.SetCondition(status.Condition{
+		Type:    v1beta1.MeterDefScrapeTargetRejected,
+		Reason:  "UnsafeScrapeTarget",
+		Status:  corev1.ConditionTrue,
+		Message: rejected.Error(),
+	})
+}
+
 func (r *MeterDefinitionReconciler) queryPreview(cc ClientCommandRunner, instance *v1beta1.MeterDefinition, request reconcile.Request, reqLogger logr.Logger) ([]common.Result, error) {
 	var queryPreviewResult []common.Result
 
+	limits := prom.QueryLimits{
+		MaxSeries:  r.cfg.ControllerValues.MeterDefQueryMaxSeries,
+		MaxSamples: r.cfg.ControllerValues.MeterDefQueryMaxSamples,
+		Timeout:    r.cfg.ControllerValues.MeterDefQueryTimeout,
+	}
+
+	meterBase, err := r.getMeterBase(context.TODO(), cc)
+	if err != nil {
+		return nil, err
+	}
+
+	if meterBase != nil && meterBase.IsExternalPrometheus() {
+		prometheusAPI, err := prom.NewExternalPromAPI(context.TODO(), r.Client, meterBase.Namespace, meterBase.Spec.ExternalPrometheus)
+		if err != nil {
+			return nil, err
+		}
+
+		prometheusAPI.Limits = limits
+
+		reqLogger.Info("generatring meterdef preview", "url", meterBase.Spec.ExternalPrometheus.URL)
+		return generateQueryPreview(instance, prometheusAPI, reqLogger)
+	}
+
 	service, err := r.queryForPrometheusService(context.TODO(), cc, request)
 	if err != nil {
 		return nil, err
@@ -272,6 +746,8 @@ func (r *MeterDefinitionReconciler) queryPreview(cc ClientCommandRunner, instanc
 			return nil, err
 		}
 
+		prometheusAPI.Limits = limits
+
 		reqLogger.Info("generatring meterdef preview")
 		return generateQueryPreview(instance, prometheusAPI, reqLogger)
 	}
@@ -279,6 +755,32 @@ func (r *MeterDefinitionReconciler) queryPreview(cc ClientCommandRunner, instanc
 	return queryPreviewResult, nil
 }
 
+// getMeterBase returns the MeterBase of the deployed namespace, or nil if
+// metering is not set up.
+func (r *MeterDefinitionReconciler) getMeterBase(
+	ctx context.Context,
+	cc ClientCommandRunner,
+) (*marketplacev1alpha1.MeterBase, error) {
+	meterBase := &marketplacev1alpha1.MeterBase{}
+
+	name := types.NamespacedName{
+		Name:      utils.METERBASE_NAME,
+		Namespace: r.cfg.DeployedNamespace,
+	}
+
+	result, _ := cc.Do(ctx, GetAction(name, meterBase))
+
+	if result.Is(NotFound) {
+		return nil, nil
+	}
+
+	if !result.Is(Continue) {
+		return nil, errors.Wrap(result, "failed to get meterbase")
+	}
+
+	return meterBase, nil
+}
+
 func (r *MeterDefinitionReconciler) queryForPrometheusService(
 	ctx context.Context,
 	cc ClientCommandRunner,
@@ -351,6 +853,10 @@ func generateQueryPreview(instance *v1beta1.MeterDefinition, prometheusAPI *prom
 
 		reqLogger.Info("meterdef preview query", "query", q)
 
+		if err := prometheusAPI.CheckQueryCost(query); err != nil {
+			return nil, err
+		}
+
 		var warnings v1.Warnings
 		err = utils.Retry(func() error {
 			var err error
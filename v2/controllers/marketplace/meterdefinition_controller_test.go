@@ -15,16 +15,28 @@
 package marketplace
 
 import (
+	"context"
+	"time"
+
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
 	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	prom "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/prometheus"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/patch"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/reconcileutils"
 	. "github.com/redhat-marketplace/redhat-marketplace-operator/v2/tests/rectest"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -87,3 +99,216 @@ var _ = Describe("MeterDefinitionController", func() {
 		testNoServiceMonitors(GinkgoT())
 	})
 })
+
+var _ = Describe("MeterDefinitionController finalizer", func() {
+	var (
+		sut       *MeterDefinitionReconciler
+		k8sClient client.Client
+		namespace = "metering-example-operator"
+		service   = &common.WorkloadResource{
+			NamespacedNameReference: common.NamespacedNameReference{
+				Name:      "example-app",
+				Namespace: namespace,
+				UID:       types.UID("service-uid"),
+				GroupVersionKind: &common.GroupVersionKind{
+					APIVersion: "v1",
+					Kind:       "Service",
+				},
+			},
+		}
+		meterdef           *v1beta1.MeterDefinition
+		serviceMonitor     *monitoringv1.ServiceMonitor
+		newMeterDefinition = func(name string, uid types.UID) *v1beta1.MeterDefinition {
+			return &v1beta1.MeterDefinition{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       name,
+					Namespace:  namespace,
+					UID:        uid,
+					Finalizers: []string{meterDefinitionFinalizer},
+				},
+				Spec: v1beta1.MeterDefinitionSpec{
+					Group: "apps.partner.metering.com",
+					Kind:  "App",
+					InstalledBy: &common.NamespacedNameReference{
+						Name:      "example-operator.v0.0.1",
+						Namespace: namespace,
+					},
+				},
+				Status: v1beta1.MeterDefinitionStatus{
+					WorkloadResources: []common.WorkloadResource{*service},
+				},
+			}
+		}
+		setup = func(objs ...runtime.Object) {
+			s := runtime.NewScheme()
+			Expect(scheme.AddToScheme(s)).To(Succeed())
+			Expect(monitoringv1.AddToScheme(s)).To(Succeed())
+			Expect(olmv1alpha1.AddToScheme(s)).To(Succeed())
+			Expect(v1beta1.AddToScheme(s)).To(Succeed())
+			log := ctrl.Log.WithName("controllers").WithName("MeterDefinitionController")
+
+			k8sClient = fake.NewFakeClientWithScheme(s, objs...)
+			sut = &MeterDefinitionReconciler{
+				Client: k8sClient,
+				Scheme: s,
+				Log:    log,
+				cfg:    &config.OperatorConfig{DeployedNamespace: "openshift-redhat-marketplace"},
+				cc:     reconcileutils.NewClientCommand(k8sClient, s, log),
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		meterdef = newMeterDefinition("example-meterdef", types.UID("meterdef-uid"))
+		serviceMonitor = &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "example-app",
+				Namespace: namespace,
+				Labels: map[string]string{
+					"marketplace.redhat.com/metering": "true",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "v1", Kind: "Service", Name: service.Name, UID: service.UID},
+				},
+			},
+		}
+	})
+
+	It("should remove the metered label and the finalizer", func() {
+		setup(meterdef, serviceMonitor)
+
+		_, err := sut.finalizeMeterDefinition(meterdef)
+		Expect(err).To(Succeed())
+
+		sm := &monitoringv1.ServiceMonitor{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: serviceMonitor.Name, Namespace: namespace}, sm)).To(Succeed())
+		Expect(utils.HasMapKey(sm.Labels, utils.MeteredAnnotation)).To(BeFalse())
+
+		result := &v1beta1.MeterDefinition{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: meterdef.Name, Namespace: namespace}, result)).To(Succeed())
+		Expect(result.GetFinalizers()).ToNot(ContainElement(meterDefinitionFinalizer))
+	})
+
+	It("should keep the metered label if another meterdefinition matches the service", func() {
+		setup(meterdef, newMeterDefinition("other-meterdef", types.UID("other-uid")), serviceMonitor)
+
+		_, err := sut.finalizeMeterDefinition(meterdef)
+		Expect(err).To(Succeed())
+
+		sm := &monitoringv1.ServiceMonitor{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: serviceMonitor.Name, Namespace: namespace}, sm)).To(Succeed())
+		Expect(utils.HasMapKey(sm.Labels, utils.MeteredAnnotation)).To(BeTrue())
+	})
+
+	It("should find meterdefinitions whose csv has been missing for the grace period", func() {
+		setup(meterdef)
+
+		orphaned, update, err := sut.isOrphaned(sut.cc, meterdef)
+		Expect(err).To(Succeed())
+		Expect(orphaned).To(BeFalse())
+		Expect(update).To(BeTrue())
+		Expect(meterdef.Status.Conditions.IsTrueFor(v1beta1.MeterDefInstallerNotFound)).To(BeTrue())
+
+		orphaned, _, err = sut.isOrphaned(sut.cc, meterdef)
+		Expect(err).To(Succeed())
+		Expect(orphaned).To(BeFalse())
+
+		for i := range meterdef.Status.Conditions {
+			meterdef.Status.Conditions[i].LastTransitionTime = metav1.NewTime(time.Now().Add(-orphanGracePeriod))
+		}
+
+		orphaned, _, err = sut.isOrphaned(sut.cc, meterdef)
+		Expect(err).To(Succeed())
+		Expect(orphaned).To(BeTrue())
+
+		csv := &olmv1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      meterdef.Spec.InstalledBy.Name,
+				Namespace: namespace,
+			},
+		}
+		setup(meterdef, csv)
+
+		orphaned, update, err = sut.isOrphaned(sut.cc, meterdef)
+		Expect(err).To(Succeed())
+		Expect(orphaned).To(BeFalse())
+		Expect(update).To(BeTrue())
+		Expect(meterdef.Status.Conditions.GetCondition(v1beta1.MeterDefInstallerNotFound)).To(BeNil())
+	})
+
+	It("should hand the meterdefinition over to the csv replacing its installer", func() {
+		csv := &olmv1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "example-operator.v0.0.2",
+				Namespace: namespace,
+				UID:       types.UID("csv-uid"),
+			},
+			Spec: olmv1alpha1.ClusterServiceVersionSpec{
+				Replaces: meterdef.Spec.InstalledBy.Name,
+			},
+		}
+		setup(meterdef, csv)
+
+		orphaned, _, err := sut.isOrphaned(sut.cc, meterdef)
+		Expect(err).To(Succeed())
+		Expect(orphaned).To(BeFalse())
+
+		result := &v1beta1.MeterDefinition{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: meterdef.Name, Namespace: namespace}, result)).To(Succeed())
+		Expect(result.Spec.InstalledBy.Name).To(Equal(csv.Name))
+		Expect(result.Spec.InstalledBy.UID).To(Equal(csv.UID))
+	})
+
+	It("should reject scrape targets reading the files of the metering prometheus", func() {
+		meterdef.Spec.ScrapeTargets = []v1beta1.ScrapeTarget{
+			{Kind: v1beta1.ScrapeTargetServiceMonitor, Name: serviceMonitor.Name},
+		}
+		serviceMonitor.Spec.Endpoints = []monitoringv1.Endpoint{
+			{Port: "metrics", BearerTokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token"},
+		}
+		setup(meterdef, serviceMonitor)
+
+		Expect(sut.checkScrapeTargets(sut.cc, meterdef, sut.Log)).To(BeTrue())
+		condition := meterdef.Status.Conditions.GetCondition(v1beta1.MeterDefScrapeTargetRejected)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.IsTrue()).To(BeTrue())
+		Expect(condition.Message).To(ContainSubstring("bearerTokenFile"))
+
+		serviceMonitor.Spec.Endpoints[0].BearerTokenFile = ""
+		setup(meterdef, serviceMonitor)
+
+		Expect(sut.checkScrapeTargets(sut.cc, meterdef, sut.Log)).To(BeTrue())
+		Expect(meterdef.Status.Conditions.GetCondition(v1beta1.MeterDefScrapeTargetRejected)).To(BeNil())
+	})
+
+	It("should keep the recording rules in sync with the meters", func() {
+		meter := v1beta1.MeterWorkload{
+			Metric:       "rpc_durations",
+			WorkloadType: v1beta1.WorkloadTypeService,
+			Aggregation:  "sum",
+			Query:        "rpc_durations_seconds_count",
+		}
+		meterdef.Spec.Meters = []v1beta1.MeterWorkload{meter}
+		setup(meterdef)
+
+		key := sut.recordingRulesKey(meterdef)
+		rule := &monitoringv1.PrometheusRule{}
+
+		Expect(sut.reconcileRecordingRules(sut.cc, meterdef)).To(Succeed())
+		Expect(k8sClient.Get(context.TODO(), key, rule)).To(Succeed())
+		Expect(rule.Labels).To(HaveKeyWithValue("marketplace.redhat.com/metering", "true"))
+		Expect(rule.Spec.Groups).To(HaveLen(1))
+		Expect(rule.Spec.Groups[0].Rules[0].Record).To(Equal(prom.RecordedMeterName))
+
+		meter.Metric = "rpc_durations_total"
+		meterdef.Spec.Meters = append(meterdef.Spec.Meters, meter)
+
+		Expect(sut.reconcileRecordingRules(sut.cc, meterdef)).To(Succeed())
+		Expect(k8sClient.Get(context.TODO(), key, rule)).To(Succeed())
+		Expect(rule.Spec.Groups).To(HaveLen(2))
+
+		_, err := sut.finalizeMeterDefinition(meterdef)
+		Expect(err).To(Succeed())
+		Expect(k8sClient.Get(context.TODO(), key, rule)).ToNot(Succeed())
+	})
+})
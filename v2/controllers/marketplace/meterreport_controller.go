@@ -24,6 +24,7 @@ import (
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
 	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/features"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/manifests"
 	mktypes "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/types"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
@@ -90,9 +91,36 @@ func (m *MeterReportReconciler) InjectOperatorConfig(cfg *config.OperatorConfig)
 func (r *MeterReportReconciler) SetupWithManager(mgr manager.Manager) error {
 	namespacePredicate := predicates.NamespacePredicate(r.cfg.DeployedNamespace)
 
+	r.Log.Info("feature gates", "reporting", r.cfg.FeatureGate.Enabled(features.Reporting))
+
+	// reconcile every report each time the feature gates change
+	gateChanged := &marketplacev1alpha1.MeterReport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: r.cfg.DeployedNamespace},
+	}
+	mapFn := handler.ToRequestsFunc(
+		func(a handler.MapObject) []reconcile.Request {
+			reports := &marketplacev1alpha1.MeterReportList{}
+			if err := r.Client.List(context.TODO(), reports, client.InNamespace(r.cfg.DeployedNamespace)); err != nil {
+				r.Log.Error(err, "failed to list meter reports")
+				return nil
+			}
+
+			requests := []reconcile.Request{}
+			for _, report := range reports.Items {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+					Name:      report.Name,
+					Namespace: report.Namespace,
+				}})
+			}
+			return requests
+		})
+
 	return ctrl.NewControllerManagedBy(mgr).
 		WithEventFilter(namespacePredicate).
 		For(&marketplacev1alpha1.MeterReport{}).
+		Watches(r.cfg.FeatureGate.Source(gateChanged, gateChanged), &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: mapFn,
+		}).
 		Watches(&source.Kind{Type: &marketplacev1alpha1.MeterReport{}}, &handler.EnqueueRequestForObject{}).
 		Watches(&source.Kind{Type: &batchv1.Job{}}, &handler.EnqueueRequestForOwner{
 			IsController: true,
@@ -181,12 +209,40 @@ func (r *MeterReportReconciler) Reconcile(request reconcile.Request) (reconcile.
 
 	// Create associated job
 	if instance.Status.AssociatedJob == nil {
+		if !r.cfg.FeatureGate.Enabled(features.Reporting) {
+			reqLogger.Info("reporting is disabled, not creating job")
+			result, _ := cc.Do(
+				context.TODO(),
+				UpdateStatusCondition(instance, &instance.Status.Conditions, marketplacev1alpha1.ReportConditionJobDisabled),
+			)
+			if result.Is(Error) {
+				reqLogger.Error(result.GetError(), "Failed to update status.")
+			}
+
+			return result.Return()
+		}
+
+		// the meterbase is only read for its resource recommendations
+		meterBase := &marketplacev1alpha1.MeterBase{}
+		if result, _ := cc.Do(context.TODO(), GetAction(types.NamespacedName{
+			Name:      utils.METERBASE_NAME,
+			Namespace: instance.Namespace,
+		}, meterBase)); !result.Is(Continue) {
+			meterBase = nil
+		}
+
 		result, _ := cc.Do(context.TODO(),
 			HandleResult(
 				manifests.CreateIfNotExistsFactoryItem(
 					job,
 					func() (runtime.Object, error) {
-						return r.factory.ReporterJob(instance, r.cfg.ReportController.RetryLimit)
+						job, err := r.factory.ReporterJob(instance, r.cfg.ReportController.RetryLimit)
+						if err != nil {
+							return nil, err
+						}
+
+						manifests.SetRecommendedResources(meterBase, marketplacev1alpha1.RecommendationComponentReporter, job.Spec.Template.Spec.Containers)
+						return job, nil
 					}, CreateWithAddController(instance),
 				),
 				OnRequeue(UpdateStatusCondition(instance, &instance.Status.Conditions, marketplacev1alpha1.ReportConditionJobSubmitted)),
@@ -25,6 +25,7 @@ import (
 	"github.com/gotidy/ptr"
 	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/features"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/manifests"
 	mktypes "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/types"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
@@ -126,6 +127,13 @@ func (r *RazeeDeploymentReconciler) SetupWithManager(mgr manager.Manager) error
 
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			label, _ := utils.GetMapKeyValue(utils.LABEL_RHM_OPERATOR_WATCH)
+
+			// the rhm-operator-secret is re-fetched when the pull secret is
+			// rotated, the secrets derived from it are updated
+			if e.MetaNew.GetName() == utils.RHM_OPERATOR_SECRET_NAME {
+				return true
+			}
+
 			// The object doesn't contain label "foo", so the event will be
 			// ignored.
 			if _, ok := e.MetaOld.GetLabels()[label]; !ok {
@@ -174,6 +182,15 @@ func (r *RazeeDeploymentReconciler) SetupWithManager(mgr manager.Manager) error
 		},
 	}
 
+	// the watch-keeper is replaced by the native resource reporter when
+	// its feature gate changes
+	razee := &marketplacev1alpha1.RazeeDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      utils.RAZEE_NAME,
+			Namespace: r.cfg.DeployedNamespace,
+		},
+	}
+
 	// Create a new controller
 	return ctrl.NewControllerManagedBy(mgr).
 		WithEventFilter(predicates.NamespacePredicate(r.cfg.DeployedNamespace)).
@@ -204,6 +221,7 @@ func (r *RazeeDeploymentReconciler) SetupWithManager(mgr manager.Manager) error
 				ToRequests: mapFn,
 			},
 			builder.WithPredicates(pp)).
+		Watches(r.cfg.FeatureGate.Source(razee, razee), &handler.EnqueueRequestForObject{}).
 		Complete(r)
 }
 
@@ -487,6 +505,52 @@ func (r *RazeeDeploymentReconciler) Reconcile(request reconcile.Request) (reconc
 		}
 	}
 
+	// The operator applies the remote resources itself, see the RemoteResourceS3Reconciler
+	verifiedRemoteResourcesEnabled := rrs3DeploymentEnabled && r.cfg.FeatureGate.Enabled(features.VerifiedRemoteResources)
+
+	if verifiedRemoteResourcesEnabled {
+		res, err := r.removeRemoteResourceS3Deployment(instance)
+		if res != nil {
+			return *res, err
+		}
+
+		reqLogger.V(0).Info("RemoteResourceS3 applied by the operator, remoteresources3 deployment not needed")
+		changed := instance.Status.Conditions.SetCondition(status.Condition{
+			Type:    marketplacev1alpha1.ConditionDeploymentEnabled,
+			Status:  corev1.ConditionTrue,
+			Reason:  marketplacev1alpha1.ReasonRhmRemoteResourceS3VerifiedEnabled,
+			Message: "RemoteResourceS3 verified and applied by the operator",
+		})
+
+		if changed {
+			_ = r.Client.Status().Update(context.TODO(), instance)
+			r.Client.Get(context.TODO(), request.NamespacedName, instance)
+		}
+	}
+
+	// The operator reports the resources itself, see runnables.ResourceReporter
+	nativeReporterEnabled := registrationEnabled && r.cfg.FeatureGate.Enabled(features.NativeResourceReporter)
+
+	if nativeReporterEnabled {
+		res, err := r.removeWatchkeeperDeployment(instance)
+		if res != nil {
+			return *res, err
+		}
+
+		reqLogger.V(0).Info("Registration reported by the operator, watch-keeper deployment not needed")
+		changed := instance.Status.Conditions.SetCondition(status.Condition{
+			Type:    marketplacev1alpha1.ConditionRegistrationEnabled,
+			Status:  corev1.ConditionTrue,
+			Reason:  marketplacev1alpha1.ReasonRhmRegistrationNativeReporterEnabled,
+			Message: "Registration reported by the operator",
+		})
+
+		if changed {
+			_ = r.Client.Status().Update(context.TODO(), instance)
+			r.Client.Get(context.TODO(), request.NamespacedName, instance)
+		}
+	}
+
 	/******************************************************************************
 	APPLY OR UPDATE RAZEE RESOURCES
 	/******************************************************************************/
@@ -868,6 +932,7 @@ func (r *RazeeDeploymentReconciler) Reconcile(request reconcile.Request) (reconc
 		}
 
 		if !reflect.DeepEqual(watchKeeperSecret.Data, updatedWatchKeeperSecret.Data) {
+			watchKeeperSecret.Data = updatedWatchKeeperSecret.Data
 			err = r.Client.Update(context.TODO(), &watchKeeperSecret)
 			if err != nil {
 				reqLogger.Error(err, "Failed to create resource", "resource: ", utils.WATCH_KEEPER_SECRET_NAME)
@@ -938,6 +1003,7 @@ func (r *RazeeDeploymentReconciler) Reconcile(request reconcile.Request) (reconc
 		}
 
 		if !reflect.DeepEqual(ibmCosReaderKey.Data, updatedibmCosReaderKey.Data) {
+			ibmCosReaderKey.Data = updatedibmCosReaderKey.Data
 			err = r.Client.Update(context.TODO(), &ibmCosReaderKey)
 			if err != nil {
 				reqLogger.Error(err, "Failed to create resource", "resource: ", utils.WATCH_KEEPER_SECRET_NAME)
@@ -972,7 +1038,7 @@ func (r *RazeeDeploymentReconciler) Reconcile(request reconcile.Request) (reconc
 		Patcher: r.patcher,
 	}
 
-	if rrs3DeploymentEnabled {
+	if rrs3DeploymentEnabled && !verifiedRemoteResourcesEnabled {
 		if result, _ := cc.Do(context.TODO(),
 			HandleResult(
 				manifests.CreateOrUpdateFactoryItemAction(
@@ -1001,7 +1067,7 @@ func (r *RazeeDeploymentReconciler) Reconcile(request reconcile.Request) (reconc
 		}
 	}
 
-	if registrationEnabled {
+	if registrationEnabled && !nativeReporterEnabled {
 		watchKeeperDeployment := &appsv1.Deployment{}
 		reqLogger.V(0).Info("Finding watch-keeper deployment")
 
@@ -1516,7 +1582,13 @@ func (r *RazeeDeploymentReconciler) removeRazeeDeployments(
 		return &reconcile.Result{RequeueAfter: time.Second * 2}, err
 	}
 
-	//Delete the deployment
+	return r.removeRemoteResourceS3Deployment(req)
+}
+
+//Undeploy the remoteresources3 deployment
+func (r *RazeeDeploymentReconciler) removeRemoteResourceS3Deployment(req *marketplacev1alpha1.RazeeDeployment) (*reconcile.Result, error) {
+	reqLogger := r.Log.WithValues("Request.Namespace", req.Namespace, "Request.Name", req.Name)
+
 	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      utils.RHM_REMOTE_RESOURCE_S3_DEPLOYMENT_NAME,
@@ -1524,7 +1596,7 @@ func (r *RazeeDeploymentReconciler) removeRazeeDeployments(
 		},
 	}
 	reqLogger.Info("deleting deployment", "name", utils.RHM_REMOTE_RESOURCE_S3_DEPLOYMENT_NAME)
-	err = r.Client.Delete(context.TODO(), deployment)
+	err := r.Client.Delete(context.TODO(), deployment)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			reqLogger.Info("deployment already deleted", "name", utils.RHM_REMOTE_RESOURCE_S3_DEPLOYMENT_NAME)
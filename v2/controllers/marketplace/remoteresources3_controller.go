@@ -16,16 +16,33 @@ package marketplace
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
 
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	emperrors "emperror.dev/errors"
 	"github.com/go-logr/logr"
 	"github.com/gotidy/ptr"
 	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	rhmclient "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/client"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/features"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/remoteresources"
+	mktypes "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/types"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/signer"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -44,9 +61,75 @@ type RemoteResourceS3Reconciler struct {
 	Client client.Client
 	Scheme *runtime.Scheme
 	Log    logr.Logger
+
+	// DynamicClient applies the verified resources, built from the manager
+	// when not set. It impersonates the razee remoteresources3 service
+	// account, so the resources are applied with the permissions razee
+	// applies them with rather than the operator's.
+	DynamicClient *rhmclient.DynamicClient
+
+	cfg     *config.OperatorConfig
+	fetcher *remoteresources.Fetcher
+	caCert  *x509.Certificate
+}
+
+func (r *RemoteResourceS3Reconciler) Inject(injector mktypes.Injectable) mktypes.SetupWithManager {
+	injector.SetCustomFields(r)
+	return r
+}
+
+func (r *RemoteResourceS3Reconciler) InjectOperatorConfig(cfg *config.OperatorConfig) error {
+	r.cfg = cfg
+	return nil
 }
 
 func (r *RemoteResourceS3Reconciler) SetupWithManager(mgr manager.Manager) error {
+	if r.DynamicClient == nil {
+		restConfig := rest.CopyConfig(mgr.GetConfig())
+		restConfig.Impersonate = rest.ImpersonationConfig{
+			UserName: fmt.Sprintf("system:serviceaccount:%s:%s", r.cfg.DeployedNamespace, utils.REMOTE_RESOURCE_S3_SERVICE_ACCOUNT),
+		}
+
+		dynamicClient, err := dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return err
+		}
+
+		r.DynamicClient = rhmclient.NewDynamicClient(dynamicClient, mgr.GetRESTMapper())
+	}
+
+	transport, err := r.cfg.Outbound.NewTransport()
+	if err != nil {
+		return err
+	}
+
+	r.fetcher = remoteresources.NewFetcher(transport)
+
+	r.caCert, err = signer.CertificateFromAssets()
+	if err != nil {
+		return err
+	}
+
+	// reconcile every RemoteResourceS3 when the gates change
+	allRemoteResources := handler.ToRequestsFunc(func(handler.MapObject) []reconcile.Request {
+		list := &marketplacev1alpha1.RemoteResourceS3List{}
+		if err := r.Client.List(context.TODO(), list); err != nil {
+			r.Log.Error(err, "failed to list remoteresources3")
+			return nil
+		}
+
+		requests := []reconcile.Request{}
+		for _, item := range list.Items {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      item.Name,
+				Namespace: item.Namespace,
+			}})
+		}
+		return requests
+	})
+
+	gateObj := &marketplacev1alpha1.RemoteResourceS3{}
+
 	labelPreds := []predicate.Predicate{
 		predicate.Funcs{
 			UpdateFunc: func(evt event.UpdateEvent) bool {
@@ -67,6 +150,9 @@ func (r *RemoteResourceS3Reconciler) SetupWithManager(mgr manager.Manager) error
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&marketplacev1alpha1.RemoteResourceS3{}).
 		Watches(&source.Kind{Type: &marketplacev1alpha1.RemoteResourceS3{}}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(labelPreds...)).
+		Watches(r.cfg.FeatureGate.Source(gateObj, gateObj), &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: allRemoteResources,
+		}).
 		Complete(r)
 }
 
@@ -91,6 +177,12 @@ func (r *RemoteResourceS3Reconciler) Reconcile(request reconcile.Request) (recon
 		return reconcile.Result{}, err
 	}
 
+	// The operator applies the requests itself, the razee remoteresources3
+	// deployment is removed, see the RazeeDeploymentReconciler
+	if r.cfg.FeatureGate.Enabled(features.VerifiedRemoteResources) {
+		return r.applyRequests(instance, reqLogger)
+	}
+
 	if instance.Status.Touched == nil {
 		instance.Status = marketplacev1alpha1.RemoteResourceS3Status{
 			Touched: ptr.Bool(true),
@@ -105,3 +197,151 @@ func (r *RemoteResourceS3Reconciler) Reconcile(request reconcile.Request) (recon
 	reqLogger.Info("finished reconcile")
 	return reconcile.Result{}, nil
 }
+
+// applyRequests fetches the content of the requests, applies it once it's
+// verified and records the fetches in the status. Like razee, a request
+// that isn't optional stops the requests after it when it isn't applied.
+func (r *RemoteResourceS3Reconciler) applyRequests(
+	instance *marketplacev1alpha1.RemoteResourceS3,
+	reqLogger logr.Logger,
+) (reconcile.Result, error) {
+	header, authErr := r.authHeader(instance)
+
+	changed := instance.Status.Touched == nil
+	instance.Status.Touched = ptr.Bool(true)
+
+	for _, request := range instance.Spec.Requests {
+		fetch := marketplacev1alpha1.RemoteResourceFetch{
+			URL:  request.Options.URL,
+			Time: metav1.Now(),
+		}
+
+		if fetch.URL == "" {
+			fetch.URL = request.Options.URI
+		}
+
+		err := authErr
+		if err == nil {
+			fetch.Digest, err = r.applyRequest(instance, fetch.URL, request.PinnedDigest, header)
+		}
+
+		switch {
+		case err == nil:
+			fetch.Result = marketplacev1alpha1.FetchResultApplied
+		case emperrors.Is(err, remoteresources.ErrRefused):
+			fetch.Result = marketplacev1alpha1.FetchResultRefused
+			fetch.Message = err.Error()
+		default:
+			fetch.Result = marketplacev1alpha1.FetchResultFailed
+			fetch.Message = err.Error()
+		}
+
+		if instance.Status.RecordFetch(fetch) {
+			reqLogger.Info("remote resource fetched", "url", fetch.URL, "digest", fetch.Digest, "result", fetch.Result, "message", fetch.Message)
+			changed = true
+		}
+
+		if fetch.Result != marketplacev1alpha1.FetchResultApplied && !request.Optional {
+			break
+		}
+	}
+
+	if changed {
+		err := r.Client.Status().Update(context.TODO(), instance)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		reqLogger.Info("updated remoteresources3")
+	}
+
+	reqLogger.Info("finished reconcile")
+	return reconcile.Result{RequeueAfter: r.cfg.RemoteResources.PollInterval}, nil
+}
+
+// applyRequest fetches the content of the URL and applies its resources
+// once verified. Returns the digest of the content.
+func (r *RemoteResourceS3Reconciler) applyRequest(
+	instance *marketplacev1alpha1.RemoteResourceS3,
+	url, pinnedDigest string,
+	header http.Header,
+) (string, error) {
+	content, err := r.fetcher.Fetch(context.TODO(), url, header)
+	if err != nil {
+		return "", err
+	}
+
+	digest := remoteresources.Digest(content)
+
+	objs, err := remoteresources.Verify(content, pinnedDigest, r.caCert)
+	if err != nil {
+		return digest, err
+	}
+
+	for i := range objs {
+		if err := r.applyObject(&objs[i], instance.Namespace); err != nil {
+			return digest, emperrors.Wrapf(err, "failed to apply %s %s", objs[i].GetKind(), objs[i].GetName())
+		}
+	}
+
+	return digest, nil
+}
+
+// applyObject merge patches the existing object with the object, so the
+// fields set by the server or other controllers are kept, or creates it.
+func (r *RemoteResourceS3Reconciler) applyObject(obj *unstructured.Unstructured, namespace string) error {
+	resourceClient, err := r.DynamicClient.ClientForObject(obj, namespace)
+	if err != nil {
+		return err
+	}
+
+	patch, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	_, err = resourceClient.Patch(context.TODO(), obj.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+	if errors.IsNotFound(err) {
+		_, err = resourceClient.Create(context.TODO(), obj, metav1.CreateOptions{})
+	}
+	return err
+}
+
+// authHeader returns the header authenticating the requests with the iam
+// api key of the auth.
+func (r *RemoteResourceS3Reconciler) authHeader(instance *marketplacev1alpha1.RemoteResourceS3) (http.Header, error) {
+	header := http.Header{}
+
+	if instance.Spec.Auth.Hmac != nil {
+		return nil, emperrors.New("hmac auth is not supported")
+	}
+
+	iam := instance.Spec.Auth.Iam
+	if iam == nil {
+		return header, nil
+	}
+
+	apiKey := iam.APIKey
+	if apiKey == "" {
+		sel := iam.APIKeyRef.ValueFrom.SecretKeyRef
+
+		secret := &corev1.Secret{}
+		err := r.Client.Get(context.TODO(), types.NamespacedName{Name: sel.Name, Namespace: instance.Namespace}, secret)
+		if err != nil {
+			return nil, emperrors.Wrap(err, "failed to get the iam api key")
+		}
+
+		key, err := utils.ExtractCredKey(secret, sel)
+		if err != nil {
+			return nil, err
+		}
+		apiKey = string(key)
+	}
+
+	token, err := r.fetcher.IAMToken(context.TODO(), iam, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	header.Set("Authorization", "Bearer "+token)
+	return header, nil
+}
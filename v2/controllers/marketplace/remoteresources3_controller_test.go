@@ -0,0 +1,300 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package marketplace
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	rhmclient "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/client"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	featuregate "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/features"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/remoteresources"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/signer"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("RemoteResourceS3", func() {
+	var (
+		namespace = "openshift-redhat-marketplace"
+		request   = reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      "child",
+			Namespace: namespace,
+		}}
+		configMaps = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+		server        *httptest.Server
+		caCert        *x509.Certificate
+		signed        []byte
+		tampered      []byte
+		r             *RemoteResourceS3Reconciler
+		dynamicClient *dynamicfake.FakeDynamicClient
+	)
+
+	newCertificate := func(name string, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).To(Succeed())
+
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(time.Now().UnixNano()),
+			Subject:               pkix.Name{CommonName: name},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  parent == nil,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		}
+
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+		Expect(err).To(Succeed())
+
+		cert, err := x509.ParseCertificate(der)
+		Expect(err).To(Succeed())
+		return cert, key
+	}
+
+	BeforeEach(func() {
+		var caKey *rsa.PrivateKey
+		caCert, caKey = newCertificate("ca", nil, nil)
+		cert, key := newCertificate("signer", caCert, caKey)
+
+		obj := unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "catalog"},
+			"data":       map[string]interface{}{"source": "signed"},
+		}}
+		Expect(signer.SignObject(&obj, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), key)).To(Succeed())
+
+		data, err := obj.MarshalJSON()
+		Expect(err).To(Succeed())
+		signed, err = yaml.JSONToYAML(data)
+		Expect(err).To(Succeed())
+
+		// the signature covers the whole object, not only its spec
+		obj.Object["data"] = map[string]interface{}{"source": "tampered"}
+		data, err = obj.MarshalJSON()
+		Expect(err).To(Succeed())
+		tampered, err = yaml.JSONToYAML(data)
+		Expect(err).To(Succeed())
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/identity/token":
+				_, _ = w.Write([]byte(`{"access_token":"token"}`))
+			case "/bucket/child.yaml":
+				if req.Header.Get("Authorization") != "Bearer token" {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				_, _ = w.Write(signed)
+			case "/bucket/tampered.yaml":
+				_, _ = w.Write(tampered)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		s := runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(marketplacev1alpha1.AddToScheme(s)).To(Succeed())
+
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+
+		dynamicClient = dynamicfake.NewSimpleDynamicClient(s)
+
+		gate := featuregate.NewFeatureGate()
+		_, err = gate.Set(map[featuregate.Feature]bool{featuregate.VerifiedRemoteResources: true})
+		Expect(err).To(Succeed())
+
+		r = &RemoteResourceS3Reconciler{
+			Scheme:        s,
+			Log:           logf.Log.WithName("remoteresources3_controller"),
+			DynamicClient: rhmclient.NewDynamicClient(dynamicClient, restMapper),
+			cfg: &config.OperatorConfig{
+				FeatureGate:     gate,
+				RemoteResources: config.RemoteResourcesConfig{PollInterval: 5 * time.Minute},
+			},
+			fetcher: remoteresources.NewFetcher(http.DefaultTransport),
+			caCert:  caCert,
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	remoteResource := func(requests ...marketplacev1alpha1.Request) *marketplacev1alpha1.RemoteResourceS3 {
+		return &marketplacev1alpha1.RemoteResourceS3{
+			ObjectMeta: metav1.ObjectMeta{Name: "child", Namespace: namespace},
+			Spec: marketplacev1alpha1.RemoteResourceS3Spec{
+				Auth: marketplacev1alpha1.Auth{
+					Iam: &marketplacev1alpha1.Iam{
+						ResponseType: "cloud_iam",
+						GrantType:    "urn:ibm:params:oauth:grant-type:apikey",
+						URL:          server.URL + "/identity/token",
+						APIKeyRef: marketplacev1alpha1.APIKeyRef{
+							ValueFrom: marketplacev1alpha1.ValueFrom{
+								SecretKeyRef: corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "rhm-cos-reader-key"},
+									Key:                  "accesskey",
+								},
+							},
+						},
+					},
+				},
+				Requests: requests,
+			},
+		}
+	}
+
+	reconcileFetches := func(instance *marketplacev1alpha1.RemoteResourceS3) []marketplacev1alpha1.RemoteResourceFetch {
+		r.Client = fake.NewFakeClientWithScheme(r.Scheme, instance, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "rhm-cos-reader-key", Namespace: namespace},
+			Data:       map[string][]byte{"accesskey": []byte("api-key")},
+		})
+
+		Expect(r.Reconcile(request)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+		Expect(r.Reconcile(request)).To(Equal(reconcile.Result{RequeueAfter: 5 * time.Minute}))
+
+		Expect(r.Client.Get(context.TODO(), request.NamespacedName, instance)).To(Succeed())
+		return instance.Status.Fetches
+	}
+
+	It("should apply verified content and record the fetch once", func() {
+		fetches := reconcileFetches(remoteResource(marketplacev1alpha1.Request{
+			Options:      marketplacev1alpha1.S3Options{URL: server.URL + "/bucket/child.yaml"},
+			PinnedDigest: remoteresources.Digest(signed),
+		}))
+
+		Expect(fetches).To(HaveLen(1))
+		Expect(fetches[0].Result).To(Equal(marketplacev1alpha1.FetchResultApplied))
+		Expect(fetches[0].Digest).To(Equal(remoteresources.Digest(signed)))
+
+		applied, err := dynamicClient.Resource(configMaps).Namespace(namespace).Get(context.TODO(), "catalog", metav1.GetOptions{})
+		Expect(err).To(Succeed())
+		Expect(applied.Object["data"]).To(HaveKeyWithValue("source", "signed"))
+	})
+
+	It("should keep the fields of the existing object", func() {
+		existing := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      "catalog",
+				"namespace": namespace,
+				"labels":    map[string]interface{}{"owner": "cluster"},
+			},
+			"data": map[string]interface{}{"source": "local", "other": "kept"},
+		}}
+		_, err := dynamicClient.Resource(configMaps).Namespace(namespace).Create(context.TODO(), existing, metav1.CreateOptions{})
+		Expect(err).To(Succeed())
+
+		fetches := reconcileFetches(remoteResource(marketplacev1alpha1.Request{
+			Options: marketplacev1alpha1.S3Options{URL: server.URL + "/bucket/child.yaml"},
+		}))
+
+		Expect(fetches).To(HaveLen(1))
+		Expect(fetches[0].Result).To(Equal(marketplacev1alpha1.FetchResultApplied))
+
+		applied, err := dynamicClient.Resource(configMaps).Namespace(namespace).Get(context.TODO(), "catalog", metav1.GetOptions{})
+		Expect(err).To(Succeed())
+		Expect(applied.Object["data"]).To(HaveKeyWithValue("source", "signed"))
+		Expect(applied.Object["data"]).To(HaveKeyWithValue("other", "kept"))
+		Expect(applied.GetLabels()).To(HaveKeyWithValue("owner", "cluster"))
+	})
+
+	It("should refuse content that isn't pinned or signed", func() {
+		fetches := reconcileFetches(remoteResource(
+			marketplacev1alpha1.Request{
+				Options:      marketplacev1alpha1.S3Options{URL: server.URL + "/bucket/child.yaml"},
+				PinnedDigest: remoteresources.Digest([]byte("expected")),
+				Optional:     true,
+			},
+			marketplacev1alpha1.Request{
+				Options: marketplacev1alpha1.S3Options{URL: server.URL + "/bucket/missing.yaml"},
+			},
+			marketplacev1alpha1.Request{
+				Options: marketplacev1alpha1.S3Options{URL: server.URL + "/bucket/after.yaml"},
+			},
+		))
+
+		Expect(fetches).To(HaveLen(2))
+		Expect(fetches[0].Result).To(Equal(marketplacev1alpha1.FetchResultRefused))
+		Expect(fetches[0].Message).To(ContainSubstring("pinned digest"))
+		Expect(fetches[1].Result).To(Equal(marketplacev1alpha1.FetchResultFailed))
+
+		fetches = reconcileFetches(remoteResource(marketplacev1alpha1.Request{
+			Options: marketplacev1alpha1.S3Options{URL: server.URL + "/bucket/tampered.yaml"},
+		}))
+
+		Expect(fetches).To(HaveLen(1))
+		Expect(fetches[0].Result).To(Equal(marketplacev1alpha1.FetchResultRefused))
+		Expect(fetches[0].Message).To(ContainSubstring("signature is not valid"))
+
+		r.caCert, _ = newCertificate("other", nil, nil)
+		fetches = reconcileFetches(remoteResource(marketplacev1alpha1.Request{
+			Options: marketplacev1alpha1.S3Options{URL: server.URL + "/bucket/child.yaml"},
+		}))
+
+		Expect(fetches).To(HaveLen(1))
+		Expect(fetches[0].Result).To(Equal(marketplacev1alpha1.FetchResultRefused))
+
+		_, err := dynamicClient.Resource(configMaps).Namespace(namespace).Get(context.TODO(), "catalog", metav1.GetOptions{})
+		Expect(err).ToNot(Succeed())
+	})
+
+	It("should bound the fetch history", func() {
+		status := marketplacev1alpha1.RemoteResourceS3Status{}
+		for i := 0; i < marketplacev1alpha1.RemoteResourceFetchHistory+5; i++ {
+			Expect(status.RecordFetch(marketplacev1alpha1.RemoteResourceFetch{
+				URL:    "https://cos/child.yaml",
+				Digest: remoteresources.Digest([]byte{byte(i)}),
+				Result: marketplacev1alpha1.FetchResultApplied,
+			})).To(BeTrue())
+		}
+
+		Expect(status.Fetches).To(HaveLen(marketplacev1alpha1.RemoteResourceFetchHistory))
+		Expect(status.Fetches[0].Digest).To(Equal(remoteresources.Digest([]byte{5})))
+	})
+})
@@ -0,0 +1,459 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package marketplace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	emperrors "emperror.dev/errors"
+	"github.com/go-logr/logr"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	rhmclient "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/client"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	mktypes "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/types"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/predicates"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// blank assignment to verify that WatchResourcePolicyReconciler implements reconcile.Reconciler
+var _ reconcile.Reconciler = &WatchResourcePolicyReconciler{}
+
+// forbiddenRetryInterval is how often kinds the operator was not allowed to
+// label are retried.
+const forbiddenRetryInterval = 15 * time.Minute
+
+// WatchResourcePolicyReconciler labels the resources selected by the watch
+// resource policy of the MarketplaceConfig with razee/watch-resource, so
+// they are reported in the cluster inventory. The resources it labels are
+// marked, so the labels are removed when the rules no longer select them.
+type WatchResourcePolicyReconciler struct {
+	// This Client, initialized using mgr.Client() above, is a split Client
+	// that reads objects from the cache and writes to the apiserver
+	Client client.Client
+	Scheme *runtime.Scheme
+	Log    logr.Logger
+
+	// DynamicClient labels the resources of any kind, built from the
+	// manager when not set.
+	DynamicClient *rhmclient.DynamicClient
+
+	cfg        *config.OperatorConfig
+	controller controller.Controller
+
+	mu       sync.RWMutex
+	rules    []marketplacev1alpha1.WatchResourceRule
+	watching map[schema.GroupVersionKind]bool
+}
+
+func (r *WatchResourcePolicyReconciler) Inject(injector mktypes.Injectable) mktypes.SetupWithManager {
+	injector.SetCustomFields(r)
+	return r
+}
+
+func (r *WatchResourcePolicyReconciler) InjectOperatorConfig(cfg *config.OperatorConfig) error {
+	r.cfg = cfg
+	return nil
+}
+
+func (r *WatchResourcePolicyReconciler) SetupWithManager(mgr manager.Manager) error {
+	if r.DynamicClient == nil {
+		dynamicClient, err := dynamic.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			return err
+		}
+
+		r.DynamicClient = rhmclient.NewDynamicClient(dynamicClient, mgr.GetRESTMapper())
+	}
+
+	c, err := ctrl.NewControllerManagedBy(mgr).
+		Named("watchresourcepolicy").
+		For(&marketplacev1alpha1.MarketplaceConfig{}, builder.WithPredicates(
+			predicates.NamespacePredicate(r.cfg.DeployedNamespace),
+		)).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	r.controller = c
+	return nil
+}
+
+// Reconcile labels the resources selected by the rules and watches their
+// kinds for resources that need labelling. The labels are removed from the
+// resources of the kinds labelled before that the rules no longer select.
+func (r *WatchResourcePolicyReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.Log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling WatchResourcePolicy")
+
+	marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
+	err := r.Client.Get(context.TODO(), request.NamespacedName, marketplaceConfig)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			reqLogger.Info("MarketplaceConfig resource not found. Ignoring since object must be deleted.")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	rules := marketplaceConfig.WatchResourceRules()
+
+	r.mu.Lock()
+	r.rules = rules
+	r.mu.Unlock()
+
+	var (
+		errs      []error
+		forbidden []string
+		kinds     []schema.GroupVersionKind
+		inRules   = map[schema.GroupVersionKind]bool{}
+		seen      = map[schema.GroupVersionKind]bool{}
+
+		// labelled are the kinds kept in the status, the ones of the rules
+		// and the removed ones that still need their labels removed
+		labelled []marketplacev1alpha1.WatchResourceKind
+	)
+
+	for _, rule := range rules {
+		inRules[schema.FromAPIVersionAndKind(rule.APIVersion, rule.Kind)] = true
+	}
+
+	for _, rule := range rules {
+		kinds = append(kinds, schema.FromAPIVersionAndKind(rule.APIVersion, rule.Kind))
+	}
+	for _, kind := range marketplaceConfig.Status.WatchResourceKinds {
+		kinds = append(kinds, schema.FromAPIVersionAndKind(kind.APIVersion, kind.Kind))
+	}
+
+	for _, gvk := range kinds {
+		if seen[gvk] {
+			continue
+		}
+		seen[gvk] = true
+
+		resourceClient, err := r.DynamicClient.ClientForKind(gvk.GroupKind(), gvk.Version)
+		if meta.IsNoMatchError(emperrors.Cause(err)) {
+			reqLogger.Info("skipping kind not served by the cluster", "kind", gvk)
+			continue
+		}
+
+		apiVersion, kind := gvk.ToAPIVersionAndKind()
+		if inRules[gvk] || err != nil {
+			labelled = append(labelled, marketplacev1alpha1.WatchResourceKind{APIVersion: apiVersion, Kind: kind})
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if inRules[gvk] {
+			if err := r.watch(request, gvk); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		err = r.label(resourceClient, gvk, rules, reqLogger)
+		if err != nil && !inRules[gvk] {
+			labelled = append(labelled, marketplacev1alpha1.WatchResourceKind{APIVersion: apiVersion, Kind: kind})
+		}
+		if errors.IsForbidden(emperrors.Cause(err)) {
+			reqLogger.Info("operator is not allowed to label kind", "kind", gvk, "err", err.Error())
+			forbidden = append(forbidden, gvk.String())
+			continue
+		}
+		if err != nil {
+			errs = append(errs, emperrors.WithDetails(err, "kind", gvk.String()))
+		}
+	}
+
+	if err := r.updateStatus(marketplaceConfig, forbidden, labelled); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := emperrors.Combine(errs...); err != nil {
+		reqLogger.Error(err, "failed to label watched resources")
+		return reconcile.Result{}, err
+	}
+
+	// rbac changes are not watched, labelling is retried in case a role
+	// granting the forbidden kinds was bound to the operator since
+	if len(forbidden) != 0 {
+		return reconcile.Result{RequeueAfter: forbiddenRetryInterval}, nil
+	}
+
+	reqLogger.Info("reconcilation complete")
+	return reconcile.Result{}, nil
+}
+
+// updateStatus records the labelled kinds and reports the kinds the operator
+// is not allowed to label on the MarketplaceConfig.
+func (r *WatchResourcePolicyReconciler) updateStatus(
+	marketplaceConfig *marketplacev1alpha1.MarketplaceConfig,
+	forbidden []string,
+	labelled []marketplacev1alpha1.WatchResourceKind,
+) error {
+	condition := status.Condition{
+		Type:    marketplacev1alpha1.ConditionWatchResourcesLabelled,
+		Status:  corev1.ConditionTrue,
+		Reason:  marketplacev1alpha1.ReasonWatchResourcesLabelled,
+		Message: "Watched resources are labelled",
+	}
+
+	if len(forbidden) != 0 {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = marketplacev1alpha1.ReasonWatchResourcesForbidden
+		condition.Message = fmt.Sprintf(
+			"Operator is not allowed to list and patch %s, bind a role granting them to the operator service account",
+			strings.Join(forbidden, ", "))
+	}
+
+	updated := marketplaceConfig.Status.Conditions.SetCondition(condition)
+
+	if !reflect.DeepEqual(marketplaceConfig.Status.WatchResourceKinds, labelled) {
+		marketplaceConfig.Status.WatchResourceKinds = labelled
+		updated = true
+	}
+
+	if !updated {
+		return nil
+	}
+
+	return r.Client.Status().Update(context.TODO(), marketplaceConfig)
+}
+
+// label sets the watch level on the resources of the kind selected by the
+// rules, and removes the labels from the resources labelled by the policy
+// that the rules no longer select.
+func (r *WatchResourcePolicyReconciler) label(
+	resourceClient dynamic.NamespaceableResourceInterface,
+	gvk schema.GroupVersionKind,
+	rules []marketplacev1alpha1.WatchResourceRule,
+	reqLogger logr.Logger,
+) error {
+	items := map[types.NamespacedName]unstructured.Unstructured{}
+
+	// the resources labelled before are listed too, in case the rules no
+	// longer select them
+	listOpts := []metav1.ListOptions{{LabelSelector: utils.RazeeWatchResourcePolicy}}
+
+	for _, rule := range rules {
+		if schema.FromAPIVersionAndKind(rule.APIVersion, rule.Kind) != gvk {
+			continue
+		}
+
+		opts := metav1.ListOptions{}
+		if rule.Selector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(rule.Selector)
+			if err != nil {
+				return emperrors.Wrap(err, "invalid selector")
+			}
+			opts.LabelSelector = selector.String()
+		}
+
+		listOpts = append(listOpts, opts)
+	}
+
+	for _, opts := range listOpts {
+		list, err := resourceClient.List(context.TODO(), opts)
+		if err != nil {
+			return emperrors.Wrap(err, "failed to list resources")
+		}
+
+		for _, item := range list.Items {
+			items[types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}] = item
+		}
+	}
+
+	for _, item := range items {
+		var patchLabels map[string]interface{}
+
+		level, ok := watchLevel(rules, gvk, &item)
+		_, marked := item.GetLabels()[utils.RazeeWatchResourcePolicy]
+
+		switch {
+		case ok && item.GetLabels()[utils.RazeeWatchResource] != string(level):
+			patchLabels = map[string]interface{}{
+				utils.RazeeWatchResource:       string(level),
+				utils.RazeeWatchResourcePolicy: "true",
+			}
+		case !ok && marked:
+			// a null value removes the label in a merge patch
+			patchLabels = map[string]interface{}{
+				utils.RazeeWatchResource:       nil,
+				utils.RazeeWatchResourcePolicy: nil,
+			}
+		default:
+			continue
+		}
+
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels": patchLabels,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = resourceClient.Namespace(item.GetNamespace()).
+			Patch(context.TODO(), item.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return emperrors.Wrapf(err, "failed to label %s", item.GetName())
+		}
+
+		if !ok {
+			reqLogger.Info("unlabelled resource", "kind", item.GetKind(), "namespace", item.GetNamespace(), "name", item.GetName())
+			continue
+		}
+
+		reqLogger.Info("labelled resource", "kind", item.GetKind(), "namespace", item.GetNamespace(), "name", item.GetName(), "level", level)
+	}
+
+	return nil
+}
+
+// watch reconciles the policy when a resource of the kind needs labelling.
+func (r *WatchResourcePolicyReconciler) watch(request reconcile.Request, gvk schema.GroupVersionKind) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.watching == nil {
+		r.watching = map[schema.GroupVersionKind]bool{}
+	}
+
+	if r.watching[gvk] || r.controller == nil {
+		return nil
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+
+	needsLabel := func(m metav1.Object) bool {
+		return r.needsLabel(gvk, m)
+	}
+
+	err := r.controller.Watch(
+		&source.Kind{Type: obj},
+		&handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(func(handler.MapObject) []reconcile.Request {
+				return []reconcile.Request{request}
+			}),
+		},
+		predicate.Funcs{
+			CreateFunc: func(e event.CreateEvent) bool {
+				return needsLabel(e.Meta)
+			},
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				return needsLabel(e.MetaNew)
+			},
+			DeleteFunc: func(event.DeleteEvent) bool {
+				return false
+			},
+			GenericFunc: func(event.GenericEvent) bool {
+				return false
+			},
+		},
+	)
+	if err != nil {
+		return emperrors.Wrapf(err, "failed to watch %s", gvk)
+	}
+
+	r.watching[gvk] = true
+	return nil
+}
+
+// needsLabel returns true if a rule selects the resource and it doesn't
+// have its watch level yet, or if the policy labelled it and no rule selects
+// it anymore.
+func (r *WatchResourcePolicyReconciler) needsLabel(gvk schema.GroupVersionKind, m metav1.Object) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	level, ok := watchLevel(r.rules, gvk, m)
+	if !ok {
+		_, marked := m.GetLabels()[utils.RazeeWatchResourcePolicy]
+		return marked
+	}
+
+	return m.GetLabels()[utils.RazeeWatchResource] != string(level)
+}
+
+// watchLevel returns the level of the rules selecting the resource. Detail
+// wins over lite, so rules with different levels selecting the same resource
+// don't keep relabelling it.
+func watchLevel(
+	rules []marketplacev1alpha1.WatchResourceRule,
+	gvk schema.GroupVersionKind,
+	m metav1.Object,
+) (marketplacev1alpha1.WatchLevel, bool) {
+	var (
+		level    marketplacev1alpha1.WatchLevel
+		selected bool
+	)
+
+	for _, rule := range rules {
+		if schema.FromAPIVersionAndKind(rule.APIVersion, rule.Kind) != gvk {
+			continue
+		}
+
+		selector := labels.Everything()
+		if rule.Selector != nil {
+			var err error
+			selector, err = metav1.LabelSelectorAsSelector(rule.Selector)
+			if err != nil {
+				continue
+			}
+		}
+
+		if !selector.Matches(labels.Set(m.GetLabels())) {
+			continue
+		}
+
+		if !selected || rule.Level == marketplacev1alpha1.WatchLevelDetail {
+			level = rule.Level
+		}
+		selected = true
+	}
+
+	return level, selected
+}
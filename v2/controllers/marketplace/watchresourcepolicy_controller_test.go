@@ -0,0 +1,283 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package marketplace
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	rhmclient "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/client"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("WatchResourcePolicy", func() {
+	var (
+		namespace = "openshift-redhat-marketplace"
+		request   = reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      utils.MARKETPLACECONFIG_NAME,
+			Namespace: namespace,
+		}}
+	)
+
+	nodes := func() []runtime.Object {
+		return []runtime.Object{
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-0"}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   "worker-1",
+				Labels: map[string]string{utils.RazeeWatchResource: utils.RazeeWatchLevelLite, "zone": "a"},
+			}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      "reported",
+				Namespace: "app",
+				Labels:    map[string]string{"inventory": "true"},
+			}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "ignored", Namespace: "app"}},
+		}
+	}
+
+	setup := func(marketplaceConfig *marketplacev1alpha1.MarketplaceConfig, objs ...runtime.Object) (*WatchResourcePolicyReconciler, *dynamicfake.FakeDynamicClient) {
+		s := runtime.NewScheme()
+		Expect(scheme.AddToScheme(s)).To(Succeed())
+		Expect(marketplacev1alpha1.AddToScheme(s)).To(Succeed())
+
+		restMapper := meta.NewDefaultRESTMapper(nil)
+		restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Node"}, meta.RESTScopeRoot)
+		restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+
+		// the fake dynamic client only lists unstructured objects
+		unstructuredObjs := []runtime.Object{}
+		for _, obj := range objs {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+			Expect(err).To(Succeed())
+
+			u := &unstructured.Unstructured{Object: content}
+			gvks, _, err := s.ObjectKinds(obj)
+			Expect(err).To(Succeed())
+			u.SetGroupVersionKind(gvks[0])
+			unstructuredObjs = append(unstructuredObjs, u)
+		}
+
+		dynamicClient := dynamicfake.NewSimpleDynamicClient(s, unstructuredObjs...)
+
+		return &WatchResourcePolicyReconciler{
+			Client:        fake.NewFakeClientWithScheme(s, marketplaceConfig),
+			Scheme:        s,
+			Log:           logf.Log.WithName("watchresourcepolicy_controller"),
+			DynamicClient: rhmclient.NewDynamicClient(dynamicClient, restMapper),
+		}, dynamicClient
+	}
+
+	labelOf := func(dynamicClient *dynamicfake.FakeDynamicClient, gvr schema.GroupVersionResource, namespace, name string) string {
+		obj, err := dynamicClient.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		Expect(err).To(Succeed())
+		return obj.GetLabels()[utils.RazeeWatchResource]
+	}
+
+	nodesResource := schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+	configMapsResource := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	It("should label the nodes without a policy", func() {
+		marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: utils.MARKETPLACECONFIG_NAME, Namespace: namespace},
+		}
+
+		r, dynamicClient := setup(marketplaceConfig, nodes()...)
+		Expect(r.Reconcile(request)).To(Equal(reconcile.Result{}))
+
+		Expect(labelOf(dynamicClient, nodesResource, "", "worker-0")).To(Equal(utils.RazeeWatchLevelLite))
+		Expect(labelOf(dynamicClient, nodesResource, "", "worker-1")).To(Equal(utils.RazeeWatchLevelLite))
+		Expect(labelOf(dynamicClient, configMapsResource, "app", "reported")).To(BeEmpty())
+	})
+
+	It("should label the resources selected by the policy", func() {
+		marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: utils.MARKETPLACECONFIG_NAME, Namespace: namespace},
+			Spec: marketplacev1alpha1.MarketplaceConfigSpec{
+				WatchResourcePolicy: &marketplacev1alpha1.WatchResourcePolicy{
+					Rules: []marketplacev1alpha1.WatchResourceRule{
+						{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+							Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"inventory": "true"}},
+							Level:      marketplacev1alpha1.WatchLevelDetail,
+						},
+						{
+							APIVersion: "v1",
+							Kind:       "Node",
+							Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"zone": "a"}},
+							Level:      marketplacev1alpha1.WatchLevelDetail,
+						},
+						{APIVersion: "example.com/v1", Kind: "Widget"},
+					},
+				},
+			},
+		}
+
+		r, dynamicClient := setup(marketplaceConfig, nodes()...)
+		Expect(r.Reconcile(request)).To(Equal(reconcile.Result{}))
+
+		Expect(labelOf(dynamicClient, configMapsResource, "app", "reported")).To(Equal(utils.RazeeWatchLevelDetail))
+		Expect(labelOf(dynamicClient, configMapsResource, "app", "ignored")).To(BeEmpty())
+		Expect(labelOf(dynamicClient, nodesResource, "", "worker-0")).To(BeEmpty())
+		Expect(labelOf(dynamicClient, nodesResource, "", "worker-1")).To(Equal(utils.RazeeWatchLevelDetail))
+
+		Expect(r.needsLabel(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, &metav1.ObjectMeta{
+			Labels: map[string]string{"inventory": "true"},
+		})).To(BeTrue())
+		Expect(r.needsLabel(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, &metav1.ObjectMeta{})).To(BeFalse())
+	})
+
+	It("should label resources selected by rules of different levels detail", func() {
+		marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: utils.MARKETPLACECONFIG_NAME, Namespace: namespace},
+			Spec: marketplacev1alpha1.MarketplaceConfigSpec{
+				WatchResourcePolicy: &marketplacev1alpha1.WatchResourcePolicy{
+					Rules: []marketplacev1alpha1.WatchResourceRule{
+						{
+							APIVersion: "v1",
+							Kind:       "Node",
+							Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"zone": "a"}},
+							Level:      marketplacev1alpha1.WatchLevelDetail,
+						},
+						{APIVersion: "v1", Kind: "Node", Level: marketplacev1alpha1.WatchLevelLite},
+					},
+				},
+			},
+		}
+
+		r, dynamicClient := setup(marketplaceConfig, nodes()...)
+		Expect(r.Reconcile(request)).To(Equal(reconcile.Result{}))
+
+		Expect(labelOf(dynamicClient, nodesResource, "", "worker-0")).To(Equal(utils.RazeeWatchLevelLite))
+		Expect(labelOf(dynamicClient, nodesResource, "", "worker-1")).To(Equal(utils.RazeeWatchLevelDetail))
+
+		Expect(r.needsLabel(schema.GroupVersionKind{Version: "v1", Kind: "Node"}, &metav1.ObjectMeta{
+			Labels: map[string]string{utils.RazeeWatchResource: utils.RazeeWatchLevelDetail, "zone": "a"},
+		})).To(BeFalse())
+
+		dynamicClient.ClearActions()
+		Expect(r.Reconcile(request)).To(Equal(reconcile.Result{}))
+
+		for _, action := range dynamicClient.Actions() {
+			Expect(action.GetVerb()).ToNot(Equal("patch"))
+		}
+	})
+
+	It("should lower or remove the labels when the rules change", func() {
+		marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: utils.MARKETPLACECONFIG_NAME, Namespace: namespace},
+			Spec: marketplacev1alpha1.MarketplaceConfigSpec{
+				WatchResourcePolicy: &marketplacev1alpha1.WatchResourcePolicy{
+					Rules: []marketplacev1alpha1.WatchResourceRule{
+						{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+							Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"inventory": "true"}},
+							Level:      marketplacev1alpha1.WatchLevelDetail,
+						},
+						{
+							APIVersion: "v1",
+							Kind:       "Node",
+							Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"zone": "a"}},
+							Level:      marketplacev1alpha1.WatchLevelDetail,
+						},
+					},
+				},
+			},
+		}
+
+		r, dynamicClient := setup(marketplaceConfig, nodes()...)
+		Expect(r.Reconcile(request)).To(Equal(reconcile.Result{}))
+
+		Expect(r.Client.Get(context.TODO(), request.NamespacedName, marketplaceConfig)).To(Succeed())
+		Expect(marketplaceConfig.Status.WatchResourceKinds).To(Equal([]marketplacev1alpha1.WatchResourceKind{
+			{APIVersion: "v1", Kind: "ConfigMap"},
+			{APIVersion: "v1", Kind: "Node"},
+		}))
+
+		marketplaceConfig.Spec.WatchResourcePolicy.Rules = []marketplacev1alpha1.WatchResourceRule{
+			{
+				APIVersion: "v1",
+				Kind:       "Node",
+				Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"zone": "a"}},
+				Level:      marketplacev1alpha1.WatchLevelLite,
+			},
+		}
+		Expect(r.Client.Update(context.TODO(), marketplaceConfig)).To(Succeed())
+
+		Expect(r.Reconcile(request)).To(Equal(reconcile.Result{}))
+
+		Expect(labelOf(dynamicClient, nodesResource, "", "worker-1")).To(Equal(utils.RazeeWatchLevelLite))
+		Expect(labelOf(dynamicClient, configMapsResource, "app", "reported")).To(BeEmpty())
+
+		obj, err := dynamicClient.Resource(configMapsResource).Namespace("app").Get(context.TODO(), "reported", metav1.GetOptions{})
+		Expect(err).To(Succeed())
+		Expect(obj.GetLabels()).To(Equal(map[string]string{"inventory": "true"}))
+
+		Expect(r.needsLabel(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, &metav1.ObjectMeta{
+			Labels: map[string]string{utils.RazeeWatchResource: utils.RazeeWatchLevelDetail, utils.RazeeWatchResourcePolicy: "true"},
+		})).To(BeTrue())
+
+		Expect(r.Client.Get(context.TODO(), request.NamespacedName, marketplaceConfig)).To(Succeed())
+		Expect(marketplaceConfig.Status.WatchResourceKinds).To(Equal([]marketplacev1alpha1.WatchResourceKind{
+			{APIVersion: "v1", Kind: "Node"},
+		}))
+	})
+
+	It("should report the kinds the operator is not allowed to label", func() {
+		marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: utils.MARKETPLACECONFIG_NAME, Namespace: namespace},
+			Spec: marketplacev1alpha1.MarketplaceConfigSpec{
+				WatchResourcePolicy: &marketplacev1alpha1.WatchResourcePolicy{
+					Rules: []marketplacev1alpha1.WatchResourceRule{
+						{APIVersion: "v1", Kind: "ConfigMap"},
+						{APIVersion: "v1", Kind: "Node"},
+					},
+				},
+			},
+		}
+
+		r, dynamicClient := setup(marketplaceConfig, nodes()...)
+		dynamicClient.PrependReactor("patch", "configmaps", func(action clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "reported", nil)
+		})
+
+		Expect(r.Reconcile(request)).To(Equal(reconcile.Result{RequeueAfter: forbiddenRetryInterval}))
+		Expect(labelOf(dynamicClient, nodesResource, "", "worker-0")).To(Equal(utils.RazeeWatchLevelLite))
+
+		Expect(r.Client.Get(context.TODO(), request.NamespacedName, marketplaceConfig)).To(Succeed())
+		condition := marketplaceConfig.Status.Conditions.GetCondition(marketplacev1alpha1.ConditionWatchResourcesLabelled)
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(marketplacev1alpha1.ReasonWatchResourcesForbidden))
+		Expect(condition.Message).To(ContainSubstring("/v1, Kind=ConfigMap"))
+	})
+})
@@ -0,0 +1,190 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package catalog builds the catalog sources listed in the MarketplaceConfig,
+// their images mapped to the mirrors of the ImageContentSourcePolicies of
+// disconnected clusters.
+package catalog
+
+import (
+	"reflect"
+	"strings"
+
+	"emperror.dev/errors"
+	operatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
+)
+
+// ManagedLabel marks the catalog sources installed by the operator.
+var ManagedLabel = []string{"marketplace.redhat.com/catalog-source", "managed"}
+
+// defaults are the catalog sources with default settings.
+var defaults = map[string]func() *olmv1alpha1.CatalogSource{
+	utils.IBM_CATALOGSRC_NAME:       utils.BuildNewIBMCatalogSrc,
+	utils.OPENCLOUD_CATALOGSRC_NAME: utils.BuildNewOpencloudCatalogSrc,
+}
+
+// Configs returns the catalog sources of the MarketplaceConfig, the IBM and
+// Opencloud catalog sources when InstallIBMCatalogSource is set and none are
+// listed.
+func Configs(marketplaceConfig *marketplacev1alpha1.MarketplaceConfig) []marketplacev1alpha1.CatalogSourceConfig {
+	if marketplaceConfig.Spec.CatalogSources != nil {
+		return marketplaceConfig.Spec.CatalogSources
+	}
+
+	install := marketplaceConfig.Spec.InstallIBMCatalogSource
+	if install == nil || !*install {
+		return nil
+	}
+
+	return []marketplacev1alpha1.CatalogSourceConfig{
+		{Name: utils.IBM_CATALOGSRC_NAME},
+		{Name: utils.OPENCLOUD_CATALOGSRC_NAME},
+	}
+}
+
+// IsManaged returns true for the catalog sources installed by the operator,
+// the ones with the managed label. The IBM and Opencloud catalog sources
+// installed before are labelled while they are listed.
+func IsManaged(catalogSrc *olmv1alpha1.CatalogSource) bool {
+	return catalogSrc.Labels[ManagedLabel[0]] == ManagedLabel[1]
+}
+
+// Build returns the catalog source of the config in the
+// openshift-marketplace namespace, its image mapped to a mirror of the
+// policies unless the config opts out.
+func Build(config marketplacev1alpha1.CatalogSourceConfig, policies []operatorv1alpha1.ImageContentSourcePolicy) (*olmv1alpha1.CatalogSource, error) {
+	catalogSrc := &olmv1alpha1.CatalogSource{
+		Spec: olmv1alpha1.CatalogSourceSpec{SourceType: olmv1alpha1.SourceTypeGrpc},
+	}
+
+	if build, ok := defaults[config.Name]; ok {
+		catalogSrc = build()
+	}
+
+	catalogSrc.Name = config.Name
+	catalogSrc.Namespace = utils.OPERATOR_MKTPLACE_NS
+	catalogSrc.Labels = map[string]string{ManagedLabel[0]: ManagedLabel[1]}
+
+	if config.DisplayName != "" {
+		catalogSrc.Spec.DisplayName = config.DisplayName
+	}
+
+	if config.Publisher != "" {
+		catalogSrc.Spec.Publisher = config.Publisher
+	}
+
+	if config.Image != "" {
+		catalogSrc.Spec.Image = config.Image
+	}
+
+	if catalogSrc.Spec.Image == "" {
+		return nil, errors.Errorf("catalog source %s has no image", config.Name)
+	}
+
+	if config.MirrorPolicy != marketplacev1alpha1.MirrorPolicyNone {
+		catalogSrc.Spec.Image = MirrorImage(catalogSrc.Spec.Image, policies)
+	}
+
+	if config.PollInterval != nil {
+		catalogSrc.Spec.UpdateStrategy = nil
+
+		if config.PollInterval.Duration > 0 {
+			interval := *config.PollInterval
+			catalogSrc.Spec.UpdateStrategy = &olmv1alpha1.UpdateStrategy{
+				RegistryPoll: &olmv1alpha1.RegistryPoll{Interval: &interval},
+			}
+		}
+	}
+
+	catalogSrc.Spec.Priority = config.Priority
+
+	return catalogSrc, nil
+}
+
+// MirrorImage returns the image pulled from the first mirror of the most
+// specific source of the policies matching its repository, the image itself
+// when none match. The policies only apply to images pulled by digest, so
+// images referenced by tag are kept as is.
+func MirrorImage(image string, policies []operatorv1alpha1.ImageContentSourcePolicy) string {
+	repository, reference := splitImage(image)
+	if !strings.HasPrefix(reference, "@") {
+		return image
+	}
+
+	var source, mirror string
+	for _, policy := range policies {
+		for _, digestMirrors := range policy.Spec.RepositoryDigestMirrors {
+			if len(digestMirrors.Mirrors) == 0 || len(digestMirrors.Source) <= len(source) {
+				continue
+			}
+
+			if repository == digestMirrors.Source || strings.HasPrefix(repository, digestMirrors.Source+"/") {
+				source, mirror = digestMirrors.Source, digestMirrors.Mirrors[0]
+			}
+		}
+	}
+
+	if source == "" {
+		return image
+	}
+
+	return mirror + strings.TrimPrefix(repository, source) + reference
+}
+
+// splitImage returns the repository of the image and its tag or digest.
+func splitImage(image string) (string, string) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		return image[:i], image[i:]
+	}
+
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i:]
+	}
+
+	return image, ""
+}
+
+// Update sets the settings of the desired catalog source on the existing
+// one, returns true if it changed.
+func Update(existing, desired *olmv1alpha1.CatalogSource) bool {
+	changed := false
+
+	if existing.Labels[ManagedLabel[0]] != ManagedLabel[1] {
+		if existing.Labels == nil {
+			existing.Labels = map[string]string{}
+		}
+		existing.Labels[ManagedLabel[0]] = ManagedLabel[1]
+		changed = true
+	}
+
+	if existing.Spec.SourceType != desired.Spec.SourceType ||
+		existing.Spec.Image != desired.Spec.Image ||
+		existing.Spec.DisplayName != desired.Spec.DisplayName ||
+		existing.Spec.Publisher != desired.Spec.Publisher ||
+		existing.Spec.Priority != desired.Spec.Priority ||
+		!reflect.DeepEqual(existing.Spec.UpdateStrategy, desired.Spec.UpdateStrategy) {
+		existing.Spec.SourceType = desired.Spec.SourceType
+		existing.Spec.Image = desired.Spec.Image
+		existing.Spec.DisplayName = desired.Spec.DisplayName
+		existing.Spec.Publisher = desired.Spec.Publisher
+		existing.Spec.Priority = desired.Spec.Priority
+		existing.Spec.UpdateStrategy = desired.Spec.UpdateStrategy
+		changed = true
+	}
+
+	return changed
+}
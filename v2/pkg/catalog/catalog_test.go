@@ -0,0 +1,99 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	operatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Catalog", func() {
+	policies := []operatorv1alpha1.ImageContentSourcePolicy{
+		{
+			Spec: operatorv1alpha1.ImageContentSourcePolicySpec{
+				RepositoryDigestMirrors: []operatorv1alpha1.RepositoryDigestMirrors{
+					{Source: "docker.io/ibmcom", Mirrors: []string{"mirror.local/ibmcom", "backup.local/ibmcom"}},
+					{Source: "docker.io/ibmcom/ibm-operator-catalog", Mirrors: []string{"mirror.local/catalogs/ibm"}},
+					{Source: "quay.io/example", Mirrors: []string{}},
+				},
+			},
+		},
+	}
+
+	It("should map images to the most specific mirror", func() {
+		Expect(MirrorImage("docker.io/ibmcom/ibm-operator-catalog@sha256:abc", policies)).To(Equal("mirror.local/catalogs/ibm@sha256:abc"))
+		Expect(MirrorImage("docker.io/ibmcom/ibm-common-service-catalog@sha256:abc", policies)).To(Equal("mirror.local/ibmcom/ibm-common-service-catalog@sha256:abc"))
+		Expect(MirrorImage("docker.io/ibmcomx/catalog@sha256:abc", policies)).To(Equal("docker.io/ibmcomx/catalog@sha256:abc"))
+		Expect(MirrorImage("quay.io/example/catalog@sha256:abc", policies)).To(Equal("quay.io/example/catalog@sha256:abc"))
+		Expect(MirrorImage("localhost:5000/catalog@sha256:abc", nil)).To(Equal("localhost:5000/catalog@sha256:abc"))
+	})
+
+	It("should keep images referenced by tag", func() {
+		Expect(MirrorImage("docker.io/ibmcom/ibm-operator-catalog", policies)).To(Equal("docker.io/ibmcom/ibm-operator-catalog"))
+		Expect(MirrorImage("docker.io/ibmcom/ibm-operator-catalog:latest", policies)).To(Equal("docker.io/ibmcom/ibm-operator-catalog:latest"))
+		Expect(MirrorImage("localhost:5000/catalog:v1", policies)).To(Equal("localhost:5000/catalog:v1"))
+	})
+
+	It("should build the catalog sources of the config", func() {
+		install := true
+		marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{
+			Spec: marketplacev1alpha1.MarketplaceConfigSpec{InstallIBMCatalogSource: &install},
+		}
+		Expect(Configs(marketplaceConfig)).To(HaveLen(2))
+
+		marketplaceConfig.Spec.CatalogSources = []marketplacev1alpha1.CatalogSourceConfig{
+			{Name: utils.IBM_CATALOGSRC_NAME, Image: "docker.io/ibmcom/ibm-operator-catalog@sha256:abc", Priority: 10, PollInterval: &metav1.Duration{}},
+			{Name: "custom", Image: "docker.io/ibmcom/custom:v1", MirrorPolicy: marketplacev1alpha1.MirrorPolicyNone},
+			{Name: "missing"},
+		}
+		configs := Configs(marketplaceConfig)
+		Expect(configs).To(HaveLen(3))
+
+		ibm, err := Build(configs[0], policies)
+		Expect(err).To(Succeed())
+		Expect(ibm.Namespace).To(Equal(utils.OPERATOR_MKTPLACE_NS))
+		Expect(ibm.Spec.DisplayName).To(Equal("IBM Operator Catalog"))
+		Expect(ibm.Spec.Image).To(Equal("mirror.local/catalogs/ibm@sha256:abc"))
+		Expect(ibm.Spec.Priority).To(Equal(10))
+		Expect(ibm.Spec.UpdateStrategy).To(BeNil())
+		Expect(IsManaged(ibm)).To(BeTrue())
+
+		custom, err := Build(configs[1], policies)
+		Expect(err).To(Succeed())
+		Expect(custom.Spec.Image).To(Equal("docker.io/ibmcom/custom:v1"))
+		Expect(custom.Spec.UpdateStrategy).To(BeNil())
+
+		_, err = Build(configs[2], policies)
+		Expect(err).ToNot(Succeed())
+
+		existing := utils.BuildNewIBMCatalogSrc()
+		Expect(IsManaged(existing)).To(BeFalse())
+		Expect(Update(existing, ibm)).To(BeTrue())
+		Expect(IsManaged(existing)).To(BeTrue())
+		Expect(existing.Spec.Image).To(Equal(ibm.Spec.Image))
+		Expect(existing.Labels).To(HaveKeyWithValue(ManagedLabel[0], ManagedLabel[1]))
+		Expect(Update(existing, ibm)).To(BeFalse())
+
+		ibm.Spec.UpdateStrategy = utils.BuildNewIBMCatalogSrc().Spec.UpdateStrategy
+		Expect(ibm.Spec.UpdateStrategy.RegistryPoll.Interval.Duration).To(Equal(45 * time.Minute))
+		Expect(Update(existing, ibm)).To(BeTrue())
+	})
+})
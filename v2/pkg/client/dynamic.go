@@ -17,6 +17,7 @@ package client
 import (
 	"emperror.dev/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 )
@@ -47,3 +48,28 @@ func (c *DynamicClient) ClientForKind(
 
 	return c.inClient.Resource(mapping.Resource), nil
 }
+
+// ClientForObject returns the client of the resource of the object. A
+// namespaced object without a namespace is set to the default namespace.
+func (c *DynamicClient) ClientForObject(
+	obj *unstructured.Unstructured,
+	defaultNamespace string) (dynamic.ResourceInterface, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := c.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get mapping")
+	}
+
+	resourceClient := c.inClient.Resource(mapping.Resource)
+
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return resourceClient, nil
+	}
+
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(defaultNamespace)
+	}
+
+	return resourceClient.Namespace(obj.GetNamespace()), nil
+}
@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	olmv1 "github.com/operator-framework/api/pkg/operators/v1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -27,7 +28,8 @@ import (
 )
 
 const (
-	IndexMeterDefinitionPods = "meterdefinition.marketplace.redhat.com/pods"
+	IndexMeterDefinitionPods        = "meterdefinition.marketplace.redhat.com/pods"
+	IndexMeterDefinitionTemplateRef = "meterdefinition.marketplace.redhat.com/templateRef"
 
 	IndexOwnerRefContains = ".metadata.ownerReferences"
 	IndexAnnotations      = ".metadata.annotations"
@@ -102,6 +104,19 @@ func AddAnnotationIndex(fieldIndexer client.FieldIndexer, types []runtime.Object
 	return nil
 }
 
+func AddMeterDefinitionTemplateRefIndex(fieldIndexer client.FieldIndexer) error {
+	return fieldIndexer.IndexField(
+		context.Background(),
+		&v1beta1.MeterDefinition{},
+		IndexMeterDefinitionTemplateRef,
+		func(obj runtime.Object) []string {
+			if meterdef, ok := obj.(*v1beta1.MeterDefinition); ok && meterdef.Spec.TemplateRef != nil {
+				return []string{meterdef.Spec.TemplateRef.Name}
+			}
+			return []string{}
+		})
+}
+
 func ObjRefToStr(apiversion, kind string) string {
 	result := strings.Split(apiversion, "/")
 
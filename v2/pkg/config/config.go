@@ -21,6 +21,8 @@ import (
 	"emperror.dev/errors"
 	"github.com/caarlos0/env/v6"
 	rhmclient "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/client"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/features"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/transport"
 	"k8s.io/client-go/discovery"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -35,12 +37,25 @@ type OperatorConfig struct {
 	DeployedPodName   string `env:"POD_NAME"`
 	ControllerValues  ControllerValues
 	ReportController  ReportControllerConfig
+	ResourceReporter  ResourceReporterConfig
+	RemoteResources   RemoteResourcesConfig
 	RelatedImages
 	OSRelatedImages
 	Features
 	Marketplace
 	*Infrastructure
 	OLMInformation
+
+	// Profile overrides the install profile detected from the infrastructure.
+	Profile InstallProfile `env:"INSTALL_PROFILE"`
+
+	// Outbound configures the transport of the clients calling out of the
+	// cluster.
+	Outbound transport.Config
+
+	// FeatureGate is the registry of the feature gates, the
+	// MarketplaceConfig sets them at runtime.
+	FeatureGate *features.FeatureGate
 }
 
 // RelatedImages stores relatedimages for the operator
@@ -56,6 +71,7 @@ type RelatedImages struct {
 	OAuthProxy                  string `env:"RELATED_IMAGE_OAUTH_PROXY" envDefault:"registry.redhat.io/openshift4/ose-oauth-proxy:latest"`
 	RemoteResourceS3            string `env:"RELATED_IMAGE_RHM_RRS3_DEPLOYMENT" envDefault:"quay.io/razee/remoteresources3:0.6.2"`
 	WatchKeeper                 string `env:"RELATED_IMAGE_RHM_WATCH_KEEPER_DEPLOYMENT" envDefault:"quay.io/razee/watch-keeper:0.6.6"`
+	KubeStateMetrics            string `env:"RELATED_IMAGE_KUBE_STATE_METRICS" envDefault:"registry.redhat.io/openshift4/ose-kube-state-metrics:latest"`
 }
 
 // OSRelatedImages stores open source related images for the operator
@@ -71,28 +87,87 @@ type OSRelatedImages struct {
 	OAuthProxy                  string `env:"OS_IMAGE_OAUTH_PROXY" envDefault:"quay.io/oauth2-proxy/oauth2-proxy:v6.1.1"`
 	RemoteResourceS3            string `env:"RELATED_IMAGE_RHM_RRS3_DEPLOYMENT" envDefault:"quay.io/razee/remoteresources3:0.6.2"`
 	WatchKeeper                 string `env:"RELATED_IMAGE_RHM_WATCH_KEEPER_DEPLOYMENT" envDefault:"quay.io/razee/watch-keeper:0.6.6"`
+	KubeStateMetrics            string `env:"OS_IMAGE_KUBE_STATE_METRICS" envDefault:"quay.io/coreos/kube-state-metrics:v1.9.7"`
 }
 
 // Features store feature flags
 type Features struct {
 	IBMCatalog bool `env:"FEATURE_IBMCATALOG" envDefault:"true"`
+
+	// Gates are the defaults of the feature gates, i.e. "Reporting=false".
+	Gates string `env:"FEATURE_GATES"`
+}
+
+// NewFeatureGate returns the feature gate with the defaults set in the
+// environment.
+func (f Features) NewFeatureGate() (*features.FeatureGate, error) {
+	gate := features.NewFeatureGate()
+
+	err := gate.SetDefaults(map[features.Feature]bool{
+		features.IBMCatalogSource: f.IBMCatalog,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = gate.SetFromString(f.Gates)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse FEATURE_GATES")
+	}
+
+	return gate, nil
 }
 
 // Marketplace configuration
 type Marketplace struct {
 	URL            string `env:"MARKETPLACE_URL" envDefault:""`
 	InsecureClient bool   `env:"MARKETPLACE_HTTP_INSECURE_MODE" envDefault:"false"`
+
+	// TokenExpiryThresholds are the times before the pull secret token
+	// expires a warning is raised at.
+	TokenExpiryThresholds []time.Duration `env:"MARKETPLACE_TOKEN_EXPIRY_THRESHOLDS" envDefault:"720h,168h,24h"`
+
+	// OfflineRegistration registers disconnected clusters by exchanging a
+	// registration request and response instead of calling the marketplace.
+	OfflineRegistration bool `env:"MARKETPLACE_OFFLINE_REGISTRATION" envDefault:"false"`
 }
 
 type ControllerValues struct {
 	DeploymentNamespace           string        `env:"POD_NAMESPACE" envDefault:"openshift-redhat-marketplace"`
 	MeterDefControllerRequeueRate time.Duration `env:"METER_DEF_CONTROLLER_REQUEUE_RATE" envDefault:"1h"`
+	MeterDefQueryMaxSeries        int           `env:"METER_DEF_QUERY_MAX_SERIES" envDefault:"0"`
+	MeterDefQueryMaxSamples       int           `env:"METER_DEF_QUERY_MAX_SAMPLES" envDefault:"0"`
+	MeterDefQueryTimeout          time.Duration `env:"METER_DEF_QUERY_TIMEOUT" envDefault:"10s"`
 }
 
 // ReportConfig stores some changeable information for creating a report
 type ReportControllerConfig struct {
 	RetryTime  time.Duration `env:"REPORT_RETRY_TIME_DURATION" envDefault:"6h"`
 	RetryLimit *int32        `env:"REPORT_RETRY_LIMIT"`
+
+	// DecommissionTimeout is how long decommissioning waits for the final
+	// report to upload before unregistering the cluster without it.
+	DecommissionTimeout time.Duration `env:"REPORT_DECOMMISSION_TIMEOUT" envDefault:"2h"`
+}
+
+// ResourceReporterConfig configures the native reporter of the resources
+// labelled for razee.
+type ResourceReporterConfig struct {
+	// BatchSize is the most resources sent in one request.
+	BatchSize int `env:"RESOURCE_REPORTER_BATCH_SIZE" envDefault:"50"`
+	// FlushInterval is how long changes wait for a batch to fill.
+	FlushInterval time.Duration `env:"RESOURCE_REPORTER_FLUSH_INTERVAL" envDefault:"10s"`
+	// RateLimit is the most requests per second sent to razeedash.
+	RateLimit float64 `env:"RESOURCE_REPORTER_RATE_LIMIT" envDefault:"1"`
+	// PollInterval is how often every resource is sent again.
+	PollInterval time.Duration `env:"RESOURCE_REPORTER_POLL_INTERVAL" envDefault:"1h"`
+}
+
+// RemoteResourcesConfig configures the RemoteResourceS3 requests applied
+// by the operator.
+type RemoteResourcesConfig struct {
+	// PollInterval is how often the requests are fetched again.
+	PollInterval time.Duration `env:"REMOTE_RESOURCES_POLL_INTERVAL" envDefault:"5m"`
 }
 
 type OLMInformation struct {
@@ -101,6 +176,20 @@ type OLMInformation struct {
 	OwnerKind      string `env:"OLM_OWNER_KIND"`
 }
 
+// InstallProfile returns the install profile set in the environment or
+// detected from the infrastructure.
+func (c *OperatorConfig) InstallProfile() InstallProfile {
+	if c.Profile != "" {
+		return c.Profile
+	}
+
+	if c.Infrastructure == nil {
+		return InstallProfileOpenshift
+	}
+
+	return c.Infrastructure.InstallProfile()
+}
+
 func reset() {
 	globalMutex.Lock()
 	defer globalMutex.Unlock()
@@ -120,6 +209,11 @@ func ProvideConfig() (*OperatorConfig, error) {
 			return nil, err
 		}
 
+		cfg.FeatureGate, err = cfg.Features.NewFeatureGate()
+		if err != nil {
+			return nil, err
+		}
+
 		cfg.Infrastructure = &Infrastructure{}
 		global = &cfg
 	}
@@ -154,6 +248,11 @@ func ProvideInfrastructureAwareConfig(
 			cfg.RelatedImages = RelatedImages(cfg.OSRelatedImages)
 		}
 
+		cfg.FeatureGate, err = cfg.Features.NewFeatureGate()
+		if err != nil {
+			return nil, err
+		}
+
 		global = cfg
 	}
 
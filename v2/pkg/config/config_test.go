@@ -18,10 +18,12 @@ import (
 	"bytes"
 	"context"
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	osconfigv1 "github.com/openshift/api/config/v1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/features"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/discovery"
 )
@@ -42,18 +44,45 @@ var _ = Describe("Config", func() {
 
 			Expect(cfg.RelatedImages.Reporter).To(Equal("reporter:latest"))
 			Expect(cfg.Features.IBMCatalog).To(BeTrue())
+			Expect(cfg.InstallProfile()).To(Equal(InstallProfileOpenshift))
+			Expect(cfg.Marketplace.TokenExpiryThresholds).To(Equal([]time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour}))
+			Expect(cfg.Marketplace.OfflineRegistration).To(BeFalse())
+			Expect(cfg.ReportController.DecommissionTimeout).To(Equal(2 * time.Hour))
+			Expect(cfg.ResourceReporter.BatchSize).To(Equal(50))
+			Expect(cfg.ResourceReporter.PollInterval).To(Equal(time.Hour))
+			Expect(cfg.RemoteResources.PollInterval).To(Equal(5 * time.Minute))
+		})
+	})
+
+	Context("with install profile", func() {
+		BeforeEach(func() {
+			os.Setenv("INSTALL_PROFILE", "kubernetes")
+			reset()
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("INSTALL_PROFILE")
+		})
+
+		It("should override the detected profile", func() {
+			cfg, err := ProvideConfig()
+
+			Expect(err).To(Succeed())
+			Expect(cfg.InstallProfile()).To(Equal(InstallProfileKubernetes))
 		})
 	})
 
 	Context("with features flag", func() {
 		BeforeEach(func() {
 			os.Setenv("FEATURE_IBMCATALOG", "false")
+			os.Setenv("FEATURE_GATES", "Reporting=false")
 			os.Setenv("RELATED_IMAGE_METRIC_STATE", "foo")
 			reset()
 		})
 
 		AfterEach(func() {
 			os.Unsetenv("FEATURE_IBMCATALOG")
+			os.Unsetenv("FEATURE_GATES")
 			os.Unsetenv("RELATED_IMAGE_METRIC_STATE")
 		})
 
@@ -63,6 +92,9 @@ var _ = Describe("Config", func() {
 			Expect(err).To(Succeed())
 			Expect(cfg).ToNot(BeNil())
 			Expect(cfg.Features.IBMCatalog).To(BeFalse())
+			Expect(cfg.FeatureGate.Enabled(features.IBMCatalogSource)).To(BeFalse())
+			Expect(cfg.FeatureGate.Enabled(features.Reporting)).To(BeFalse())
+			Expect(cfg.FeatureGate.Enabled(features.Metering)).To(BeTrue())
 			Expect(cfg.RelatedImages.MetricState).To(Equal("foo"))
 		})
 	})
@@ -76,6 +108,8 @@ var _ = Describe("Config", func() {
 			Expect(cfg.Infrastructure.KubernetesVersion()).NotTo(BeEmpty())
 			Expect(cfg.Infrastructure.KubernetesPlatform()).NotTo(BeEmpty())
 			Expect(cfg.Infrastructure.HasOpenshift()).To(BeFalse())
+			Expect(cfg.InstallProfile()).To(Equal(InstallProfileKubernetes))
+			Expect(cfg.RelatedImages.KubeStateMetrics).To(Equal(cfg.OSRelatedImages.KubeStateMetrics))
 		})
 	})
 
@@ -171,6 +205,7 @@ status:
 			Expect(err).To(Succeed())
 			Expect(i.Infrastructure.OpenshiftVersion()).NotTo(BeEmpty())
 			Expect(i.Infrastructure.OpenshiftVersion()).To(Equal("4.6.4"))
+			Expect(i.InstallProfile()).To(Equal(InstallProfileOpenshift))
 		})
 	})
 })
@@ -145,3 +145,31 @@ func (i *Infrastructure) IsDefined() bool {
 	defer i.Unlock()
 	return i.openshift != nil || i.kubernetes != nil
 }
+
+// InstallProfile selects how the operator installs its components on the
+// cluster.
+type InstallProfile string
+
+const (
+	// InstallProfileOpenshift relies on the OpenShift service CA, OAuth and
+	// cluster monitoring.
+	InstallProfileOpenshift InstallProfile = "openshift"
+
+	// InstallProfileKubernetes runs on vanilla Kubernetes. The operator
+	// issues its own serving certificates, deploys kube-state-metrics and
+	// authenticates with kube-rbac-proxy only.
+	InstallProfileKubernetes InstallProfile = "kubernetes"
+)
+
+// InstallProfile returns the profile for the infrastructure. A cluster is
+// only treated as vanilla Kubernetes if it was detected without OpenShift.
+func (i *Infrastructure) InstallProfile() InstallProfile {
+	i.Lock()
+	defer i.Unlock()
+
+	if i.kubernetes != nil && i.openshift == nil {
+		return InstallProfileKubernetes
+	}
+
+	return InstallProfileOpenshift
+}
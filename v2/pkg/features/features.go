@@ -0,0 +1,340 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package features is the registry of the feature gates of the operator
+// subsystems. Gates default per their maturity, the environment overrides
+// the defaults and the MarketplaceConfig overrides both.
+package features
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"emperror.dev/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Feature is the name of a feature gate.
+type Feature string
+
+// Maturity is how mature a feature is.
+type Maturity string
+
+const (
+	// Alpha features are disabled by default and may change or be removed.
+	Alpha Maturity = "Alpha"
+	// Beta features are enabled by default and well tested.
+	Beta Maturity = "Beta"
+	// GA features are enabled by default and stable.
+	GA Maturity = "GA"
+)
+
+// FeatureSpec is the default and maturity of a feature.
+type FeatureSpec struct {
+	Default  bool
+	Maturity Maturity
+}
+
+const (
+	// Deployment deploys the razee remoteresources3 deployment.
+	Deployment Feature = "Deployment"
+	// Registration deploys the razee watch-keeper that registers the cluster.
+	Registration Feature = "Registration"
+	// IBMCatalogSource installs the IBM and Opencloud catalog sources when
+	// the MarketplaceConfig doesn't say.
+	IBMCatalogSource Feature = "IBMCatalogSource"
+	// Metering installs the MeterBase. Disabling it stops reconciling the
+	// MeterBase, the metering stack already installed keeps running until
+	// the MeterBase is deleted.
+	Metering Feature = "Metering"
+	// Reporting creates the daily MeterReports and runs their jobs.
+	Reporting Feature = "Reporting"
+	// ResourceRecommendations recommends the resources of the metering
+	// components from their observed usage.
+	ResourceRecommendations Feature = "ResourceRecommendations"
+	// NativeResourceReporter reports the resources labelled for razee from
+	// the operator instead of the razee watch-keeper deployment.
+	NativeResourceReporter Feature = "NativeResourceReporter"
+	// VerifiedRemoteResources applies the RemoteResourceS3 requests from the
+	// operator once their signatures are verified instead of the razee
+	// remoteresources3 deployment. They are applied as its service account.
+	VerifiedRemoteResources Feature = "VerifiedRemoteResources"
+)
+
+var defaultFeatures = map[Feature]FeatureSpec{
+	Deployment:              {Default: true, Maturity: GA},
+	Registration:            {Default: true, Maturity: GA},
+	IBMCatalogSource:        {Default: true, Maturity: GA},
+	Metering:                {Default: true, Maturity: GA},
+	Reporting:               {Default: true, Maturity: GA},
+	ResourceRecommendations: {Default: true, Maturity: Beta},
+	NativeResourceReporter:  {Default: false, Maturity: Alpha},
+	VerifiedRemoteResources: {Default: false, Maturity: Alpha},
+}
+
+// FeatureGate is the registry of the feature gates. A nil gate has every
+// feature at its default.
+type FeatureGate struct {
+	mu sync.RWMutex
+
+	known     map[Feature]FeatureSpec
+	defaults  map[Feature]bool
+	overrides map[Feature]bool
+
+	subscribers []subscriber
+}
+
+// subscriber is notified without blocking, a pending notification already
+// covers the change.
+type subscriber func()
+
+// NewFeatureGate returns a gate with the features of the operator.
+func NewFeatureGate() *FeatureGate {
+	f := &FeatureGate{
+		known:     map[Feature]FeatureSpec{},
+		defaults:  map[Feature]bool{},
+		overrides: map[Feature]bool{},
+	}
+
+	for feature, spec := range defaultFeatures {
+		f.known[feature] = spec
+	}
+
+	return f
+}
+
+// Add registers features. A feature can't be registered twice with
+// different specs.
+func (f *FeatureGate) Add(features map[Feature]FeatureSpec) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for feature, spec := range features {
+		if known, ok := f.known[feature]; ok && known != spec {
+			return errors.Errorf("feature gate %s is already registered", feature)
+		}
+	}
+
+	for feature, spec := range features {
+		f.known[feature] = spec
+	}
+
+	return nil
+}
+
+// SetDefaults overrides the defaults of the features, i.e. from the
+// environment.
+func (f *FeatureGate) SetDefaults(defaults map[Feature]bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for feature, enabled := range defaults {
+		if _, ok := f.known[feature]; !ok {
+			return errors.Errorf("unknown feature gate %s", feature)
+		}
+
+		f.defaults[feature] = enabled
+	}
+
+	return nil
+}
+
+// SetFromString overrides the defaults of the features from a comma
+// separated list of gates, i.e. "Reporting=false,Metering=true".
+func (f *FeatureGate) SetFromString(gates string) error {
+	defaults := map[Feature]bool{}
+
+	for _, gate := range strings.Split(gates, ",") {
+		gate = strings.TrimSpace(gate)
+		if gate == "" {
+			continue
+		}
+
+		parts := strings.SplitN(gate, "=", 2)
+		if len(parts) != 2 {
+			return errors.Errorf("feature gate %s is not name=bool", gate)
+		}
+
+		enabled, err := strconv.ParseBool(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return errors.Wrapf(err, "feature gate %s is not name=bool", gate)
+		}
+
+		defaults[Feature(strings.TrimSpace(parts[0]))] = enabled
+	}
+
+	return f.SetDefaults(defaults)
+}
+
+// Set replaces the overrides of the features, features no longer
+// overridden go back to their defaults. Unknown features are skipped and
+// returned as an error. Returns true if any feature changed, subscribers
+// are notified of the change.
+func (f *FeatureGate) Set(overrides map[Feature]bool) (bool, error) {
+	if f == nil {
+		return false, errors.New("feature gate is not configured")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	before := f.effective()
+
+	var errs []error
+	f.overrides = map[Feature]bool{}
+
+	for feature, enabled := range overrides {
+		if _, ok := f.known[feature]; !ok {
+			errs = append(errs, errors.Errorf("unknown feature gate %s", feature))
+			continue
+		}
+
+		f.overrides[feature] = enabled
+	}
+
+	after := f.effective()
+
+	changed := false
+	for feature, enabled := range after {
+		if before[feature] != enabled {
+			changed = true
+		}
+	}
+
+	if changed {
+		f.notify()
+	}
+
+	return changed, errors.Combine(errs...)
+}
+
+// Enabled returns true if the feature is enabled. Unknown features are
+// disabled.
+func (f *FeatureGate) Enabled(feature Feature) bool {
+	if f == nil {
+		return defaultFeatures[feature].Default
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.enabled(feature)
+}
+
+// Spec returns the spec of the feature.
+func (f *FeatureGate) Spec(feature Feature) (FeatureSpec, bool) {
+	if f == nil {
+		spec, ok := defaultFeatures[feature]
+		return spec, ok
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	spec, ok := f.known[feature]
+	return spec, ok
+}
+
+// Features returns the known features sorted by name.
+func (f *FeatureGate) Features() []Feature {
+	known := defaultFeatures
+
+	if f != nil {
+		f.mu.RLock()
+		defer f.mu.RUnlock()
+		known = f.known
+	}
+
+	features := make([]Feature, 0, len(known))
+	for feature := range known {
+		features = append(features, feature)
+	}
+
+	sort.Slice(features, func(i, j int) bool {
+		return features[i] < features[j]
+	})
+
+	return features
+}
+
+// Source returns a source sending an event for the object each time a
+// feature changes, controllers watch it to reconcile on change.
+func (f *FeatureGate) Source(meta metav1.Object, obj runtime.Object) source.Source {
+	events := make(chan event.GenericEvent, 1)
+
+	if f != nil {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		f.subscribers = append(f.subscribers, func() {
+			select {
+			case events <- event.GenericEvent{Meta: meta, Object: obj}:
+			default:
+			}
+		})
+	}
+
+	return &source.Channel{Source: events}
+}
+
+// Notify returns a channel receiving each time a feature changes, for
+// components that aren't controllers.
+func (f *FeatureGate) Notify() <-chan struct{} {
+	changes := make(chan struct{}, 1)
+
+	if f != nil {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		f.subscribers = append(f.subscribers, func() {
+			select {
+			case changes <- struct{}{}:
+			default:
+			}
+		})
+	}
+
+	return changes
+}
+
+func (f *FeatureGate) enabled(feature Feature) bool {
+	if enabled, ok := f.overrides[feature]; ok {
+		return enabled
+	}
+
+	if enabled, ok := f.defaults[feature]; ok {
+		return enabled
+	}
+
+	return f.known[feature].Default
+}
+
+func (f *FeatureGate) effective() map[Feature]bool {
+	effective := map[Feature]bool{}
+	for feature := range f.known {
+		effective[feature] = f.enabled(feature)
+	}
+	return effective
+}
+
+func (f *FeatureGate) notify() {
+	for _, notify := range f.subscribers {
+		notify()
+	}
+}
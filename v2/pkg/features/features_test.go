@@ -0,0 +1,104 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package features
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var _ = Describe("FeatureGate", func() {
+	var gate *FeatureGate
+
+	BeforeEach(func() {
+		gate = NewFeatureGate()
+	})
+
+	It("should default the features", func() {
+		Expect(gate.Enabled(Metering)).To(BeTrue())
+		Expect(gate.Enabled("Unknown")).To(BeFalse())
+
+		var nilGate *FeatureGate
+		Expect(nilGate.Enabled(Reporting)).To(BeTrue())
+		Expect(nilGate.Features()).To(Equal(gate.Features()))
+	})
+
+	It("should register alpha features disabled", func() {
+		Expect(gate.Add(map[Feature]FeatureSpec{"Preview": {Maturity: Alpha}})).To(Succeed())
+		Expect(gate.Enabled("Preview")).To(BeFalse())
+		Expect(gate.Features()).To(ContainElement(Feature("Preview")))
+
+		Expect(gate.Add(map[Feature]FeatureSpec{Metering: {Maturity: Alpha}})).ToNot(Succeed())
+	})
+
+	It("should set the defaults from a string", func() {
+		Expect(gate.SetFromString("Reporting=false, Metering=true")).To(Succeed())
+		Expect(gate.Enabled(Reporting)).To(BeFalse())
+		Expect(gate.Enabled(Metering)).To(BeTrue())
+
+		Expect(gate.SetFromString("Reporting")).ToNot(Succeed())
+		Expect(gate.SetFromString("Unknown=true")).ToNot(Succeed())
+	})
+
+	It("should override the defaults until unset", func() {
+		Expect(gate.SetFromString("Reporting=false")).To(Succeed())
+
+		changed, err := gate.Set(map[Feature]bool{Reporting: true, "Unknown": true})
+		Expect(err).To(MatchError(ContainSubstring("Unknown")))
+		Expect(changed).To(BeTrue())
+		Expect(gate.Enabled(Reporting)).To(BeTrue())
+
+		changed, err = gate.Set(map[Feature]bool{Reporting: true})
+		Expect(err).To(Succeed())
+		Expect(changed).To(BeFalse())
+
+		changed, err = gate.Set(nil)
+		Expect(err).To(Succeed())
+		Expect(changed).To(BeTrue())
+		Expect(gate.Enabled(Reporting)).To(BeFalse())
+	})
+
+	It("should send an event on change", func() {
+		obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "watched", Namespace: "ns"}}
+		src := gate.Source(obj, obj).(*source.Channel)
+
+		stop := make(chan struct{})
+		defer close(stop)
+		Expect(src.InjectStopChannel(stop)).To(Succeed())
+
+		queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		defer queue.ShutDown()
+		Expect(src.Start(&handler.EnqueueRequestForObject{}, queue)).To(Succeed())
+
+		changes := gate.Notify()
+
+		_, err := gate.Set(map[Feature]bool{Metering: false})
+		Expect(err).To(Succeed())
+		Expect(changes).To(Receive())
+
+		item, _ := queue.Get()
+		Expect(item).To(Equal(reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      "watched",
+			Namespace: "ns",
+		}}))
+	})
+})
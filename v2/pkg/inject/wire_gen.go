@@ -53,7 +53,13 @@ func initializeInjectDependencies(cache2 cache.Cache, fields *managers.Controlle
 		Client:  clientset,
 		Factory: factory,
 	}
-	runnablesRunnables := runnables.ProvideRunnables(crdUpdater)
+	resourceReporter := &runnables.ResourceReporter{
+		Logger: logger,
+		CC:     clientCommandRunner,
+		Config: operatorConfig,
+		Rest:   restConfig,
+	}
+	runnablesRunnables := runnables.ProvideRunnables(crdUpdater, resourceReporter)
 	clientCommandInjector := &ClientCommandInjector{
 		Fields:        fields,
 		CommandRunner: clientCommandRunner,
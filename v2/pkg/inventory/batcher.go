@@ -0,0 +1,289 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Batcher batches the changes of the watched resources and sends them no
+// faster than its rate limit. Changes to a resource between two sends are
+// collapsed into one and changes that don't change what is reported, i.e.
+// node heartbeats, aren't sent. Informer resyncs are sent as polls.
+type Batcher struct {
+	sender        Sender
+	batchSize     int
+	flushInterval time.Duration
+	limiter       *rate.Limiter
+	log           logr.Logger
+
+	mu      sync.Mutex
+	pending map[types.UID]pendingEvent
+	order   []types.UID
+	sent    map[types.UID]uint64
+	full    chan struct{}
+}
+
+type pendingEvent struct {
+	uid    types.UID
+	event  Event
+	digest uint64
+}
+
+var _ cache.ResourceEventHandler = &Batcher{}
+
+// NewBatcher returns a batcher sending batches of at most batchSize
+// events every flushInterval, or as soon as a batch is full, at most limit
+// batches per second.
+func NewBatcher(
+	sender Sender,
+	batchSize int,
+	flushInterval time.Duration,
+	limit rate.Limit,
+	log logr.Logger,
+) *Batcher {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	return &Batcher{
+		sender:        sender,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		limiter:       rate.NewLimiter(limit, 1),
+		log:           log,
+		pending:       map[types.UID]pendingEvent{},
+		sent:          map[types.UID]uint64{},
+		full:          make(chan struct{}, 1),
+	}
+}
+
+func (r *Batcher) OnAdd(obj interface{}) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		r.queue(Added, u)
+	}
+}
+
+func (r *Batcher) OnUpdate(oldObj, newObj interface{}) {
+	oldU, ok := oldObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	newU, ok := newObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	if oldU.GetResourceVersion() == newU.GetResourceVersion() {
+		r.queue(Polled, newU)
+		return
+	}
+
+	r.queue(Modified, newU)
+}
+
+func (r *Batcher) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		r.queue(Deleted, u)
+	}
+}
+
+// Pending returns the number of events waiting to be sent.
+func (r *Batcher) Pending() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.pending)
+}
+
+// Run sends the events until the context is done.
+func (r *Batcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.full:
+		}
+
+		if err := r.Flush(ctx); err != nil {
+			r.log.Error(err, "failed to send resources, retrying", "pending", r.Pending())
+		}
+	}
+}
+
+// Flush sends the pending events in batches. Batches that fail are
+// retried on the next flush unless the resource changed again.
+func (r *Batcher) Flush(ctx context.Context) error {
+	for {
+		batch := r.take()
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := r.limiter.Wait(ctx); err != nil {
+			r.requeue(batch)
+			return err
+		}
+
+		events := make([]Event, 0, len(batch))
+		for _, p := range batch {
+			events = append(events, p.event)
+		}
+
+		if err := r.sender.Send(ctx, events); err != nil {
+			r.requeue(batch)
+			return err
+		}
+
+		r.markSent(batch)
+		r.log.V(4).Info("sent resources", "count", len(batch))
+	}
+}
+
+func (r *Batcher) queue(eventType EventType, obj *unstructured.Unstructured) {
+	object := Trim(obj)
+	digest, err := digestOf(object)
+	if err != nil {
+		r.log.Error(err, "failed to digest resource", "kind", obj.GetKind(), "name", obj.GetName())
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	uid := obj.GetUID()
+
+	switch eventType {
+	case Deleted:
+		delete(r.sent, uid)
+	case Polled:
+	default:
+		if sent, ok := r.sent[uid]; ok && sent == digest {
+			if _, queued := r.pending[uid]; !queued {
+				return
+			}
+		}
+	}
+
+	if _, queued := r.pending[uid]; !queued {
+		r.order = append(r.order, uid)
+	}
+
+	r.pending[uid] = pendingEvent{
+		uid:    uid,
+		event:  Event{Type: eventType, Object: object},
+		digest: digest,
+	}
+
+	if len(r.pending) >= r.batchSize {
+		select {
+		case r.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (r *Batcher) take() []pendingEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := len(r.order)
+	if n > r.batchSize {
+		n = r.batchSize
+	}
+
+	batch := make([]pendingEvent, 0, n)
+	for _, uid := range r.order[:n] {
+		batch = append(batch, r.pending[uid])
+		delete(r.pending, uid)
+	}
+
+	r.order = r.order[n:]
+	return batch
+}
+
+func (r *Batcher) requeue(batch []pendingEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, p := range batch {
+		// a newer change supersedes the failed one
+		if _, queued := r.pending[p.uid]; queued {
+			continue
+		}
+
+		r.pending[p.uid] = p
+		r.order = append(r.order, p.uid)
+	}
+}
+
+func (r *Batcher) markSent(batch []pendingEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, p := range batch {
+		if p.event.Type == Deleted {
+			continue
+		}
+
+		r.sent[p.uid] = p.digest
+	}
+}
+
+// digestOf hashes the reported object without the fields that change
+// without changing the resource.
+func digestOf(object map[string]interface{}) (uint64, error) {
+	stable := runtime.DeepCopyJSON(object)
+
+	unstructured.RemoveNestedField(stable, "metadata", "resourceVersion")
+
+	if conditions, ok, _ := unstructured.NestedSlice(stable, "status", "conditions"); ok {
+		for i := range conditions {
+			if condition, ok := conditions[i].(map[string]interface{}); ok {
+				delete(condition, "lastHeartbeatTime")
+			}
+		}
+		_ = unstructured.SetNestedSlice(stable, conditions, "status", "conditions")
+	}
+
+	data, err := json.Marshal(stable)
+	if err != nil {
+		return 0, err
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return h.Sum64(), nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inventory reports the resources labelled razee/watch-resource to
+// razeedash with the payload of the razee watch-keeper, so the operator can
+// report the cluster inventory without deploying it.
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// EventType is the type of a change as razeedash receives it.
+type EventType string
+
+const (
+	Added    EventType = "ADDED"
+	Modified EventType = "MODIFIED"
+	Deleted  EventType = "DELETED"
+	// Polled resources are sent again periodically even if they didn't
+	// change, razeedash expires the resources it doesn't hear about.
+	Polled EventType = "POLLED"
+)
+
+// Event is a change of a resource.
+type Event struct {
+	Type   EventType              `json:"type"`
+	Object map[string]interface{} `json:"object"`
+}
+
+// Config is the razeedash the resources are reported to.
+type Config struct {
+	URL       string
+	ClusterID string
+	OrgKey    string
+}
+
+// IsValid returns true if the config is complete.
+func (c Config) IsValid() bool {
+	return c.URL != "" && c.ClusterID != "" && c.OrgKey != ""
+}
+
+// Sender sends a batch of events.
+type Sender interface {
+	Send(ctx context.Context, events []Event) error
+}
+
+// Client sends the events to razeedash.
+type Client struct {
+	config     Config
+	httpClient *http.Client
+}
+
+// NewClient returns a client sending to razeedash through the transport.
+func NewClient(config Config, transport http.RoundTripper) *Client {
+	return &Client{
+		config: config,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   time.Minute,
+		},
+	}
+}
+
+// Send posts the events to the resources of the cluster.
+func (c *Client) Send(ctx context.Context, events []Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal events")
+	}
+
+	resourcesURL := fmt.Sprintf("%s/clusters/%s/resources",
+		strings.TrimSuffix(c.config.URL, "/"), url.PathEscape(c.config.ClusterID))
+
+	req, err := http.NewRequest(http.MethodPost, resourcesURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to build request")
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("razee-org-key", c.config.OrgKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send resources")
+	}
+	defer resp.Body.Close()
+
+	// drain the body so the connection is reused
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.WithDetails(errors.New("razeedash rejected the resources"), "status", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Trim returns the object as it is reported for its razee/watch-resource
+// level. Lite resources only report their metadata and status, detail
+// resources report the whole object. Managed fields and the last applied
+// configuration are never reported and neither is secret data.
+func Trim(obj *unstructured.Unstructured) map[string]interface{} {
+	object := obj.DeepCopy().Object
+
+	unstructured.RemoveNestedField(object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(object, "metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration")
+
+	if annotations, ok, _ := unstructured.NestedMap(object, "metadata", "annotations"); ok && len(annotations) == 0 {
+		unstructured.RemoveNestedField(object, "metadata", "annotations")
+	}
+
+	if obj.GetKind() == "Secret" {
+		unstructured.RemoveNestedField(object, "data")
+		unstructured.RemoveNestedField(object, "stringData")
+	}
+
+	if obj.GetLabels()[utils.RazeeWatchResource] == utils.RazeeWatchLevelDetail {
+		return object
+	}
+
+	lite := map[string]interface{}{}
+	for _, field := range []string{"apiVersion", "kind", "metadata", "status"} {
+		if value, ok := object[field]; ok {
+			lite[field] = value
+		}
+	}
+
+	return lite
+}
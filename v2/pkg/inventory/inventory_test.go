@@ -0,0 +1,199 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"emperror.dev/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type fakeSender struct {
+	batches [][]Event
+	err     error
+}
+
+func (s *fakeSender) Send(ctx context.Context, events []Event) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.batches = append(s.batches, events)
+	return nil
+}
+
+func node(uid, resourceVersion, heartbeat string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Node",
+		"metadata": map[string]interface{}{
+			"name":            "node-" + uid,
+			"uid":             uid,
+			"resourceVersion": resourceVersion,
+			"labels":          map[string]interface{}{"razee/watch-resource": "lite"},
+		},
+		"spec": map[string]interface{}{"podCIDR": "10.128.0.0/23"},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "lastHeartbeatTime": heartbeat},
+			},
+		},
+	}}
+}
+
+var _ = Describe("Inventory", func() {
+	It("should trim lite resources to their metadata and status", func() {
+		obj := node("1", "1", "t1")
+		obj.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"})
+
+		lite := Trim(obj)
+		Expect(lite).ToNot(HaveKey("spec"))
+		Expect(lite).To(HaveKey("status"))
+		Expect(lite["metadata"]).ToNot(HaveKey("annotations"))
+
+		obj.SetLabels(map[string]string{"razee/watch-resource": "detail"})
+		Expect(Trim(obj)).To(HaveKey("spec"))
+	})
+
+	It("should post the events to the resources of the cluster", func() {
+		received := make(chan []Event, 1)
+		status := http.StatusOK
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				return
+			}
+
+			Expect(req.Method).To(Equal(http.MethodPost))
+			Expect(req.URL.Path).To(Equal("/api/v2/clusters/cluster-uuid/resources"))
+			Expect(req.Header.Get("razee-org-key")).To(Equal("org-key"))
+
+			events := []Event{}
+			Expect(json.NewDecoder(req.Body).Decode(&events)).To(Succeed())
+			received <- events
+		}))
+		defer server.Close()
+
+		client := NewClient(Config{
+			URL:       server.URL + "/api/v2/",
+			ClusterID: "cluster-uuid",
+			OrgKey:    "org-key",
+		}, http.DefaultTransport)
+
+		Expect(client.Send(context.TODO(), []Event{{Type: Added, Object: Trim(node("1", "1", "t1"))}})).To(Succeed())
+		Expect(<-received).To(HaveLen(1))
+
+		status = http.StatusUnauthorized
+		Expect(client.Send(context.TODO(), []Event{})).ToNot(Succeed())
+	})
+
+	Describe("batcher", func() {
+		var sender *fakeSender
+		var batcher *Batcher
+
+		BeforeEach(func() {
+			sender = &fakeSender{}
+			batcher = NewBatcher(sender, 2, time.Hour, rate.Inf, logf.Log)
+		})
+
+		It("should batch and collapse the changes", func() {
+			batcher.OnAdd(node("1", "1", "t1"))
+			batcher.OnUpdate(node("1", "1", "t1"), func() *unstructured.Unstructured {
+				n := node("1", "2", "t1")
+				n.SetLabels(map[string]string{"razee/watch-resource": "lite", "role": "worker"})
+				return n
+			}())
+			batcher.OnAdd(node("2", "1", "t1"))
+			batcher.OnAdd(node("3", "1", "t1"))
+
+			Expect(batcher.Flush(context.TODO())).To(Succeed())
+			Expect(sender.batches).To(HaveLen(2))
+			Expect(sender.batches[0]).To(HaveLen(2))
+			Expect(sender.batches[0][0].Type).To(Equal(Modified))
+			Expect(sender.batches[1]).To(HaveLen(1))
+		})
+
+		It("should skip changes that aren't reported", func() {
+			batcher.OnAdd(node("1", "1", "t1"))
+			Expect(batcher.Flush(context.TODO())).To(Succeed())
+
+			batcher.OnUpdate(node("1", "1", "t1"), node("1", "2", "t2"))
+			Expect(batcher.Pending()).To(Equal(0))
+
+			batcher.OnUpdate(node("1", "2", "t2"), node("1", "2", "t2"))
+			batcher.OnDelete(node("2", "1", "t1"))
+			Expect(batcher.Pending()).To(Equal(2))
+
+			Expect(batcher.Flush(context.TODO())).To(Succeed())
+			Expect(sender.batches[1][0].Type).To(Equal(Polled))
+			Expect(sender.batches[1][1].Type).To(Equal(Deleted))
+		})
+
+		It("should retry failed batches", func() {
+			sender.err = errors.New("unavailable")
+
+			batcher.OnAdd(node("1", "1", "t1"))
+			Expect(batcher.Flush(context.TODO())).ToNot(Succeed())
+			Expect(batcher.Pending()).To(Equal(1))
+
+			sender.err = nil
+			Expect(batcher.Flush(context.TODO())).To(Succeed())
+			Expect(sender.batches).To(HaveLen(1))
+			Expect(batcher.Pending()).To(Equal(0))
+		})
+	})
+
+	It("should only watch the served resources", func() {
+		discovery := fakeclientset.NewSimpleClientset().Discovery().(*fakediscovery.FakeDiscovery)
+		discovery.Resources = []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "nodes", Kind: "Node"},
+					{Name: "nodes/status", Kind: "Node"},
+					{Name: "configmaps", Kind: "ConfigMap"},
+				},
+			},
+		}
+
+		policy, err := KindResources(discovery, []schema.GroupVersionKind{
+			{Version: "v1", Kind: "Node"},
+			{Group: "example.com", Version: "v1", Kind: "Widget"},
+		})
+		Expect(err).To(Succeed())
+
+		served, err := ServedResources(discovery, append(policy, Resources...))
+		Expect(err).To(Succeed())
+		Expect(served).To(Equal([]schema.GroupVersionResource{
+			{Version: "v1", Resource: "nodes"},
+			{Version: "v1", Resource: "configmaps"},
+		}))
+	})
+})
@@ -0,0 +1,174 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Resources are the resources the operator labels razee/watch-resource
+// itself, the kinds of the watch resource policy are watched as well.
+var Resources = []schema.GroupVersionResource{
+	{Version: "v1", Resource: "configmaps"},
+	{Group: "operators.coreos.com", Version: "v1alpha1", Resource: "clusterserviceversions"},
+	{Group: "config.openshift.io", Version: "v1", Resource: "clusterversions"},
+	{Group: "config.openshift.io", Version: "v1", Resource: "consoles"},
+	{Group: "config.openshift.io", Version: "v1", Resource: "infrastructures"},
+	{Group: "marketplace.redhat.com", Version: "v1alpha1", Resource: "marketplaceconfigs"},
+}
+
+// ServedResources returns the resources served by the cluster, once each,
+// i.e. kubernetes clusters don't serve the OpenShift config resources.
+func ServedResources(
+	client discovery.DiscoveryInterface,
+	resources []schema.GroupVersionResource,
+) ([]schema.GroupVersionResource, error) {
+	gvs := []schema.GroupVersion{}
+	for _, gvr := range resources {
+		gvs = append(gvs, gvr.GroupVersion())
+	}
+
+	isServed := map[schema.GroupVersionResource]bool{}
+
+	err := discoverResources(client, gvs, func(gv schema.GroupVersion, resource metav1.APIResource) {
+		isServed[gv.WithResource(resource.Name)] = true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	served := []schema.GroupVersionResource{}
+	for _, gvr := range resources {
+		if isServed[gvr] {
+			served = append(served, gvr)
+			isServed[gvr] = false
+		}
+	}
+
+	return served, nil
+}
+
+// KindResources returns the resources of the kinds served by the cluster,
+// kinds the cluster doesn't serve are skipped.
+func KindResources(
+	client discovery.DiscoveryInterface,
+	kinds []schema.GroupVersionKind,
+) ([]schema.GroupVersionResource, error) {
+	gvs := []schema.GroupVersion{}
+	for _, gvk := range kinds {
+		gvs = append(gvs, gvk.GroupVersion())
+	}
+
+	resources := []schema.GroupVersionResource{}
+
+	err := discoverResources(client, gvs, func(gv schema.GroupVersion, resource metav1.APIResource) {
+		// subresources share the kind of their resource
+		if strings.Contains(resource.Name, "/") {
+			return
+		}
+
+		for _, gvk := range kinds {
+			if gvk.GroupVersion() == gv && gvk.Kind == resource.Kind {
+				resources = append(resources, gv.WithResource(resource.Name))
+			}
+		}
+	})
+
+	return resources, err
+}
+
+// discoverResources calls found for the resources of the group versions
+// served by the cluster.
+func discoverResources(
+	client discovery.DiscoveryInterface,
+	gvs []schema.GroupVersion,
+	found func(schema.GroupVersion, metav1.APIResource),
+) error {
+	groups, err := client.ServerGroups()
+	if err != nil {
+		return errors.Wrap(err, "failed to discover groups")
+	}
+
+	versions := map[schema.GroupVersion]bool{}
+	for _, group := range groups.Groups {
+		for _, version := range group.Versions {
+			versions[schema.GroupVersion{Group: group.Name, Version: version.Version}] = true
+		}
+	}
+
+	discovered := map[schema.GroupVersion]bool{}
+
+	for _, gv := range gvs {
+		if !versions[gv] || discovered[gv] {
+			continue
+		}
+
+		list, err := client.ServerResourcesForGroupVersion(gv.String())
+		if err != nil {
+			return errors.Wrapf(err, "failed to discover %s", gv)
+		}
+
+		discovered[gv] = true
+
+		for _, resource := range list.APIResources {
+			found(gv, resource)
+		}
+	}
+
+	return nil
+}
+
+// Watch informs the handler of the changes to the resources labelled
+// razee/watch-resource until the context is done. Every resync the
+// resources are informed again as updates without a new version.
+func Watch(
+	ctx context.Context,
+	client dynamic.Interface,
+	resources []schema.GroupVersionResource,
+	resync time.Duration,
+	handler cache.ResourceEventHandler,
+) error {
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(
+		client, resync, metav1.NamespaceAll,
+		func(opts *metav1.ListOptions) {
+			opts.LabelSelector = utils.RazeeWatchResource
+		})
+
+	for _, gvr := range resources {
+		factory.ForResource(gvr).Informer().AddEventHandler(handler)
+	}
+
+	factory.Start(ctx.Done())
+
+	for gvr, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return errors.Errorf("failed to sync %s", gvr)
+		}
+	}
+
+	<-ctx.Done()
+	return nil
+}
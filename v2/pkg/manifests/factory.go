@@ -29,11 +29,13 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/prometheus"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -57,6 +59,7 @@ const (
 	PrometheusDatasourcesSecret      = "assets/prometheus/prometheus-datasources-secret.yaml"
 	PrometheusServingCertsCABundle   = "assets/prometheus/serving-certs-ca-bundle.yaml"
 	PrometheusKubeletServingCABundle = "assets/prometheus/kubelet-serving-ca-bundle.yaml"
+	KubeStateMetricsServiceMonitor   = "assets/prometheus/kube-state-service-monitor.yaml"
 
 	ReporterJob = "assets/reporter/job.yaml"
 
@@ -91,6 +94,12 @@ func NewFactory(
 	}
 }
 
+// IsKubernetesProfile returns true if the components are installed without
+// the OpenShift service CA, OAuth and cluster monitoring.
+func (f *Factory) IsKubernetesProfile() bool {
+	return f.operatorConfig.InstallProfile() == config.InstallProfileKubernetes
+}
+
 func (f *Factory) ReplaceImages(container *corev1.Container) {
 	switch {
 	case strings.HasPrefix(container.Name, "kube-rbac-proxy"):
@@ -142,6 +151,26 @@ func (f *Factory) ReplaceImages(container *corev1.Container) {
 		container.Image = f.config.RelatedImages.PrometheusOperator
 	case container.Name == "prometheus-proxy":
 		container.Image = f.config.RelatedImages.OAuthProxy
+	case container.Name == "kube-state-metrics":
+		container.Image = f.config.RelatedImages.KubeStateMetrics
+	}
+}
+
+// SetRecommendedResources sets the resources of the containers to the
+// recommendations of the component if the MeterBase applies them.
+func SetRecommendedResources(
+	meterBase *marketplacev1alpha1.MeterBase,
+	component string,
+	containers []corev1.Container,
+) {
+	if meterBase == nil || !meterBase.Spec.AutoApplyRecommendations {
+		return
+	}
+
+	for i := range containers {
+		if resources, ok := meterBase.Status.Recommendations.Recommendation(component, containers[i].Name); ok {
+			containers[i].Resources = resources
+		}
 	}
 }
 
@@ -257,6 +286,17 @@ func (f *Factory) PrometheusService(instanceName string) (*v1.Service, error) {
 
 	s.Spec.Selector["prometheus"] = instanceName
 
+	// without the OAuth proxy only the kube-rbac-proxy port is served
+	if f.IsKubernetesProfile() {
+		ports := []corev1.ServicePort{}
+		for _, port := range s.Spec.Ports {
+			if port.Name != "https" {
+				ports = append(ports, port)
+			}
+		}
+		s.Spec.Ports = ports
+	}
+
 	return s, nil
 }
 
@@ -364,6 +404,10 @@ func (f *Factory) NewPrometheusDeployment(
 		p.Spec.Retention = f.config.PrometheusConfig.Retention
 	}
 
+	if cr.Spec.Prometheus.Retention != "" {
+		p.Spec.Retention = cr.Spec.Prometheus.Retention
+	}
+
 	//Set empty dir if present in the CR, will override a pvc specified (per prometheus docs)
 	if cr.Spec.Prometheus.Storage.EmptyDir != nil {
 		p.Spec.Storage.EmptyDir = cr.Spec.Prometheus.Storage.EmptyDir
@@ -375,11 +419,15 @@ func (f *Factory) NewPrometheusDeployment(
 	}
 
 	quanBytes := cr.Spec.Prometheus.Storage.Size.DeepCopy()
-	quanBytes.Sub(resource.MustParse("2Gi"))
+	quanBytes.Sub(resource.MustParse(PrometheusStorageHeadroom))
 	replacer := strings.NewReplacer("Mi", "MB", "Gi", "GB", "Ti", "TB")
 	storageSize := replacer.Replace(quanBytes.String())
 	p.Spec.RetentionSize = storageSize
 
+	if cr.Spec.Prometheus.RetentionSize != "" {
+		p.Spec.RetentionSize = cr.Spec.Prometheus.RetentionSize
+	}
+
 	pvc, err := utils.NewPersistentVolumeClaim(utils.PersistentVolume{
 		ObjectMeta: &metav1.ObjectMeta{
 			Name: "storage-volume",
@@ -392,6 +440,39 @@ func (f *Factory) NewPrometheusDeployment(
 		Spec: pvc.Spec,
 	}
 
+	// rules recorded for meter definitions are created in the deployed namespace
+	p.Spec.RuleSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			utils.MeteredAnnotation[0]: utils.MeteredAnnotation[1],
+		},
+	}
+
+	// replicas are told apart by their external labels and spread across nodes
+	p.Spec.PrometheusExternalLabelName = ptr.String(prometheus.PrometheusExternalLabelName)
+	p.Spec.ReplicaExternalLabelName = ptr.String(prometheus.ReplicaExternalLabelName)
+	p.Spec.Affinity = &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+				{
+					Weight: 100,
+					PodAffinityTerm: corev1.PodAffinityTerm{
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"prometheus": cr.Name,
+							},
+						},
+						Namespaces:  []string{p.Namespace},
+						TopologyKey: corev1.LabelHostname,
+					},
+				},
+			},
+		},
+	}
+
+	if rw := cr.Spec.Prometheus.RemoteWrite; rw != nil {
+		p.Spec.RemoteWrite = []monitoringv1.RemoteWriteSpec{newRemoteWriteSpec(p, rw)}
+	}
+
 	if cfg != nil {
 		p.Spec.AdditionalScrapeConfigs = &corev1.SecretKeySelector{
 			LocalObjectReference: corev1.LocalObjectReference{
@@ -401,6 +482,25 @@ func (f *Factory) NewPrometheusDeployment(
 		}
 	}
 
+	// the prometheus container is not part of the manifest, its resources
+	// are set on the spec. Resources of the MeterBase take precedence over
+	// the recommendations.
+	SetRecommendedResources(cr, marketplacev1alpha1.RecommendationComponentPrometheus, p.Spec.Containers)
+
+	if cr.Spec.AutoApplyRecommendations {
+		if resources, ok := cr.Status.Recommendations.Recommendation(marketplacev1alpha1.RecommendationComponentPrometheus, "prometheus"); ok {
+			p.Spec.Resources = resources
+		}
+	}
+
+	if resources := cr.Spec.Prometheus.ResourceRequirements; len(resources.Requests) > 0 || len(resources.Limits) > 0 {
+		p.Spec.Resources = resources
+	}
+
+	if f.IsKubernetesProfile() {
+		removeOpenshiftPrometheusDependencies(p)
+	}
+
 	for i := range p.Spec.Containers {
 		f.ReplaceImages(&p.Spec.Containers[i])
 	}
@@ -408,6 +508,134 @@ func (f *Factory) NewPrometheusDeployment(
 	return p, err
 }
 
+// removeOpenshiftPrometheusDependencies removes the OAuth proxy, its secrets
+// and the kubelet CA copied from cluster monitoring. Queries are
+// authenticated by kube-rbac-proxy token reviews instead.
+func removeOpenshiftPrometheusDependencies(p *monitoringv1.Prometheus) {
+	containers := []corev1.Container{}
+	for _, container := range p.Spec.Containers {
+		if container.Name != "prometheus-proxy" {
+			containers = append(containers, container)
+		}
+	}
+	p.Spec.Containers = containers
+
+	secrets := []string{}
+	for _, secret := range p.Spec.Secrets {
+		if secret != "rhm-prometheus-meterbase-proxy" && secret != "rhm-prometheus-meterbase-htpasswd" {
+			secrets = append(secrets, secret)
+		}
+	}
+	p.Spec.Secrets = secrets
+
+	configMaps := []string{}
+	for _, configMap := range p.Spec.ConfigMaps {
+		if configMap != "kubelet-serving-ca-bundle" {
+			configMaps = append(configMaps, configMap)
+		}
+	}
+	p.Spec.ConfigMaps = configMaps
+}
+
+// NewPrometheusPodDisruptionBudget returns the disruption budget keeping one
+// replica of a highly available Prometheus running.
+func (f *Factory) NewPrometheusPodDisruptionBudget(
+	cr *marketplacev1alpha1.MeterBase,
+) *policyv1beta1.PodDisruptionBudget {
+	minAvailable := intstr.FromInt(1)
+
+	return &policyv1beta1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cr.Name,
+			Namespace: f.namespace,
+			Labels: map[string]string{
+				"app":        "prometheus",
+				"prometheus": cr.Name,
+			},
+		},
+		Spec: policyv1beta1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"prometheus": cr.Name,
+				},
+			},
+		},
+	}
+}
+
+// PrometheusStorageHeadroom is the storage left out of the retention size
+// for the WAL and compaction.
+const PrometheusStorageHeadroom = "2Gi"
+
+// MeteringSeriesRegex matches the series that are remote written: the
+// meterdef_* series and the recorded meter series.
+const MeteringSeriesRegex = "meterdef_.+|meterdef:.+"
+
+// newRemoteWriteSpec converts the remote write of the MeterBase. Secrets and
+// config maps the endpoint references are mounted on the Prometheus pods and
+// passed to the endpoint as files.
+func newRemoteWriteSpec(
+	p *monitoringv1.Prometheus,
+	rw *marketplacev1alpha1.PrometheusRemoteWriteSpec,
+) monitoringv1.RemoteWriteSpec {
+	spec := monitoringv1.RemoteWriteSpec{
+		URL:  rw.URL,
+		Name: "metering",
+		WriteRelabelConfigs: []monitoringv1.RelabelConfig{
+			{
+				SourceLabels: []string{"__name__"},
+				Regex:        MeteringSeriesRegex,
+				Action:       "keep",
+			},
+		},
+		BasicAuth: rw.BasicAuth.DeepCopy(),
+	}
+
+	if rw.BearerTokenSecret != nil {
+		spec.BearerTokenFile = mountPrometheusSecret(p, *rw.BearerTokenSecret)
+	}
+
+	if rw.TLSConfig != nil {
+		tlsConfig := &monitoringv1.TLSConfig{}
+		tlsConfig.ServerName = rw.TLSConfig.ServerName
+		tlsConfig.InsecureSkipVerify = rw.TLSConfig.InsecureSkipVerify
+		tlsConfig.CAFile = mountPrometheusSecretOrConfigMap(p, rw.TLSConfig.CA)
+		tlsConfig.CertFile = mountPrometheusSecretOrConfigMap(p, rw.TLSConfig.Cert)
+
+		if rw.TLSConfig.KeySecret != nil {
+			tlsConfig.KeyFile = mountPrometheusSecret(p, *rw.TLSConfig.KeySecret)
+		}
+
+		spec.TLSConfig = tlsConfig
+	}
+
+	return spec
+}
+
+func mountPrometheusSecret(p *monitoringv1.Prometheus, sel corev1.SecretKeySelector) string {
+	if !utils.Contains(p.Spec.Secrets, sel.Name) {
+		p.Spec.Secrets = append(p.Spec.Secrets, sel.Name)
+	}
+
+	return fmt.Sprintf("/etc/prometheus/secrets/%s/%s", sel.Name, sel.Key)
+}
+
+func mountPrometheusSecretOrConfigMap(p *monitoringv1.Prometheus, sel monitoringv1.SecretOrConfigMap) string {
+	switch {
+	case sel.Secret != nil:
+		return mountPrometheusSecret(p, *sel.Secret)
+	case sel.ConfigMap != nil:
+		if !utils.Contains(p.Spec.ConfigMaps, sel.ConfigMap.Name) {
+			p.Spec.ConfigMaps = append(p.Spec.ConfigMaps, sel.ConfigMap.Name)
+		}
+
+		return fmt.Sprintf("/etc/prometheus/configmaps/%s/%s", sel.ConfigMap.Name, sel.ConfigMap.Key)
+	default:
+		return ""
+	}
+}
+
 func (f *Factory) NewPrometheusOperatorService() (*corev1.Service, error) {
 	service, err := f.NewService(MustAssetReader(PrometheusOperatorService))
 
@@ -509,6 +737,17 @@ func (f *Factory) ReporterJob(
 		container.Args = append(container.Args, report.Spec.ExtraArgs...)
 	}
 
+	// the reporter uploads through the same proxy as the operator
+	for _, env := range []corev1.EnvVar{
+		{Name: "HTTP_PROXY", Value: f.operatorConfig.Outbound.HTTPProxy},
+		{Name: "HTTPS_PROXY", Value: f.operatorConfig.Outbound.HTTPSProxy},
+		{Name: "NO_PROXY", Value: f.operatorConfig.Outbound.NoProxy},
+	} {
+		if env.Value != "" {
+			container.Env = append(container.Env, env)
+		}
+	}
+
 	// Keep last 3 days of data
 	j.Spec.TTLSecondsAfterFinished = ptr.Int32(86400 * 3)
 	j.Spec.Template.Spec.Containers[0] = container
@@ -892,7 +1131,7 @@ func (f *Factory) NewRemoteResourceS3Deployment(instance *marketplacev1alpha1.Ra
 					Name: utils.RHM_REMOTE_RESOURCE_S3_DEPLOYMENT_NAME,
 				},
 				Spec: corev1.PodSpec{
-					ServiceAccountName: "redhat-marketplace-remoteresources3deployment",
+					ServiceAccountName: utils.REMOTE_RESOURCE_S3_SERVICE_ACCOUNT,
 					Containers: []corev1.Container{
 						{
 							Image:           f.config.RelatedImages.AuthChecker,
@@ -0,0 +1,261 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"fmt"
+
+	"github.com/gotidy/ptr"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	KubeStateMetricsName      = "rhm-kube-state-metrics"
+	KubeStateMetricsTLSSecret = "rhm-kube-state-metrics-tls"
+
+	// KubeStateMetricsServiceAccount is installed with the operator. Its
+	// cluster role only lists and watches the resources kube-state-metrics
+	// collects, and reviews the tokens of the kube-rbac-proxy clients.
+	KubeStateMetricsServiceAccount = "redhat-marketplace-kube-state-metrics"
+)
+
+// kube-rbac-proxy serves the kube-state-metrics ports listening on localhost
+// with the same cipher suites as the other components.
+const kubeRBACProxyCipherSuites = "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,TLS_RSA_WITH_AES_128_CBC_SHA256,TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256,TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256"
+
+func kubeStateMetricsLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/component": "exporter",
+		"app.kubernetes.io/name":      KubeStateMetricsName,
+		"k8s-app":                     "kube-state-metrics",
+	}
+}
+
+// KubeStateMetricsDeployment returns the kube-state-metrics the Kubernetes
+// profile deploys in place of the one of OpenShift cluster monitoring.
+func (f *Factory) KubeStateMetricsDeployment() *appsv1.Deployment {
+	labels := kubeStateMetricsLabels()
+	maxSurge := intstr.FromString("25%")
+	maxUnavailable := intstr.FromString("25%")
+
+	proxy := func(name string, port int32, upstream int32, portName string) corev1.Container {
+		return corev1.Container{
+			Name:            name,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Args: []string{
+				"--logtostderr",
+				fmt.Sprintf("--secure-listen-address=:%d", port),
+				"--tls-cipher-suites=" + kubeRBACProxyCipherSuites,
+				fmt.Sprintf("--upstream=http://127.0.0.1:%d/", upstream),
+				"--tls-cert-file=/etc/tls/private/tls.crt",
+				"--tls-private-key-file=/etc/tls/private/tls.key",
+			},
+			Ports: []corev1.ContainerPort{
+				{
+					ContainerPort: port,
+					Name:          portName,
+				},
+			},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10m"),
+					corev1.ResourceMemory: resource.MustParse("20Mi"),
+				},
+			},
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      KubeStateMetricsTLSSecret,
+					MountPath: "/etc/tls/private",
+					ReadOnly:  true,
+				},
+			},
+		}
+	}
+
+	dep := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      KubeStateMetricsName,
+			Namespace: f.namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.Int32(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: KubeStateMetricsServiceAccount,
+					NodeSelector: map[string]string{
+						"kubernetes.io/os": "linux",
+					},
+					Containers: []corev1.Container{
+						{
+							Name:            "kube-state-metrics",
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args: []string{
+								"--host=127.0.0.1",
+								"--port=8081",
+								"--telemetry-host=127.0.0.1",
+								"--telemetry-port=8082",
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("10m"),
+									corev1.ResourceMemory: resource.MustParse("100Mi"),
+								},
+							},
+							TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+						},
+						proxy("kube-rbac-proxy-main", 8443, 8081, "https-main"),
+						proxy("kube-rbac-proxy-self", 9443, 8082, "https-self"),
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: KubeStateMetricsTLSSecret,
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: KubeStateMetricsTLSSecret,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i := range dep.Spec.Template.Spec.Containers {
+		f.ReplaceImages(&dep.Spec.Template.Spec.Containers[i])
+	}
+
+	return dep
+}
+
+// KubeStateMetricsService returns the service of kube-state-metrics. Its
+// serving certificate is issued to the secret the annotation names.
+func (f *Factory) KubeStateMetricsService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      KubeStateMetricsName,
+			Namespace: f.namespace,
+			Labels:    kubeStateMetricsLabels(),
+			Annotations: map[string]string{
+				ServingCertSecretAnnotation: KubeStateMetricsTLSSecret,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "https-main",
+					Port:       8443,
+					TargetPort: intstr.FromString("https-main"),
+				},
+				{
+					Name:       "https-self",
+					Port:       9443,
+					TargetPort: intstr.FromString("https-self"),
+				},
+			},
+			Selector: kubeStateMetricsLabels(),
+			Type:     corev1.ServiceTypeClusterIP,
+		},
+	}
+}
+
+// KubeStateMetricsServiceMonitor returns the monitor of the kube-state-metrics
+// deployed by the operator. It is only used to generate the scrape config.
+func (f *Factory) KubeStateMetricsServiceMonitor() (*monitoringv1.ServiceMonitor, error) {
+	sm, err := f.NewServiceMonitor(MustAssetReader(KubeStateMetricsServiceMonitor))
+	if err != nil {
+		return nil, err
+	}
+
+	sm.Namespace = f.namespace
+	setServerName(sm, KubeStateMetricsName)
+
+	return sm, nil
+}
+
+// KubeletServiceMonitor returns the monitor of the kubelets. The kubelet
+// service in kube-system is maintained by the prometheus operator. Kubelets
+// of vanilla clusters serve self-signed certificates, so they are not
+// verified.
+func (f *Factory) KubeletServiceMonitor() *monitoringv1.ServiceMonitor {
+	endpoint := func(path string) monitoringv1.Endpoint {
+		return monitoringv1.Endpoint{
+			Port:            "https-metrics",
+			Path:            path,
+			Scheme:          "https",
+			Interval:        "2m",
+			ScrapeTimeout:   "1m",
+			HonorLabels:     true,
+			BearerTokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token",
+			TLSConfig: &monitoringv1.TLSConfig{
+				SafeTLSConfig: monitoringv1.SafeTLSConfig{
+					InsecureSkipVerify: true,
+				},
+			},
+		}
+	}
+
+	return &monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kubelet",
+			Namespace: f.namespace,
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			JobLabel: "k8s-app",
+			Endpoints: []monitoringv1.Endpoint{
+				endpoint("/metrics"),
+				endpoint("/metrics/cadvisor"),
+			},
+			NamespaceSelector: monitoringv1.NamespaceSelector{
+				MatchNames: []string{"kube-system"},
+			},
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"k8s-app": "kubelet",
+				},
+			},
+		},
+	}
+}
+
+// setServerName verifies the endpoints of the monitor against the serving
+// certificate of the service.
+func setServerName(sm *monitoringv1.ServiceMonitor, serviceName string) {
+	for i := range sm.Spec.Endpoints {
+		if tls := sm.Spec.Endpoints[i].TLSConfig; tls != nil {
+			tls.ServerName = fmt.Sprintf("%s.%s.svc", serviceName, sm.Namespace)
+		}
+	}
+}
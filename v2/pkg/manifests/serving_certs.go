@@ -0,0 +1,222 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifests
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"emperror.dev/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ServingCertSecretAnnotation names the secret the OpenShift service CA
+	// issues the serving certificate of a service to.
+	ServingCertSecretAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
+
+	// InjectCABundleAnnotation asks the OpenShift service CA to inject its
+	// bundle in a config map.
+	InjectCABundleAnnotation = "service.beta.openshift.io/inject-cabundle"
+
+	// ServingCASecretName is the secret of the CA issuing the serving
+	// certificates when there is no service CA.
+	ServingCASecretName = "rhm-serving-ca"
+
+	// ServingCABundleKey is the config map key of the CA bundle, the same
+	// key the service CA injects.
+	ServingCABundleKey = "service-ca.crt"
+
+	servingCAValidity   = 10 * 365 * 24 * time.Hour
+	servingCertValidity = 2 * 365 * 24 * time.Hour
+
+	// servingCertRenewBefore is how long before expiry a serving
+	// certificate is issued again.
+	servingCertRenewBefore = 30 * 24 * time.Hour
+)
+
+// ServingCASecret returns a new self-signed CA for the serving certificates.
+func (f *Factory) ServingCASecret() (*corev1.Secret, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate ca key")
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: fmt.Sprintf("%s@%d", ServingCASecretName, now.Unix()),
+		},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(servingCAValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certPEM, err := newCertificate(template, template, key, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.newTLSSecret(ServingCASecretName, certPEM, key), nil
+}
+
+// ServingCertSecret issues the serving certificate of the service with the
+// CA. The secret is named by the serving cert annotation of the service so
+// the same services work with and without the service CA.
+func (f *Factory) ServingCertSecret(service *corev1.Service, ca *corev1.Secret) (*corev1.Secret, error) {
+	name := service.GetAnnotations()[ServingCertSecretAnnotation]
+	if name == "" {
+		return nil, errors.NewWithDetails("service has no serving cert annotation", "service", service.Name)
+	}
+
+	caCert, caKey, err := parseTLSSecret(ca)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse ca")
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate serving key")
+	}
+
+	host := fmt.Sprintf("%s.%s.svc", service.Name, service.Namespace)
+	now := time.Now()
+	template := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: host,
+		},
+		DNSNames: []string{
+			host,
+			host + ".cluster.local",
+			"*." + host,
+			"*." + host + ".cluster.local",
+		},
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    now.Add(servingCertValidity),
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	certPEM, err := newCertificate(template, caCert, key, caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := f.newTLSSecret(name, certPEM, key)
+	secret.Namespace = service.Namespace
+
+	return secret, nil
+}
+
+// WithServingCA sets the CA bundle of a config map the service CA would
+// inject into.
+func (f *Factory) WithServingCA(cm *corev1.ConfigMap, ca *corev1.Secret) *corev1.ConfigMap {
+	cm = cm.DeepCopy()
+	delete(cm.Annotations, InjectCABundleAnnotation)
+	cm.Data = map[string]string{
+		ServingCABundleKey: string(ca.Data[corev1.TLSCertKey]),
+	}
+
+	return cm
+}
+
+// ServingCertNeedsRenewal returns true if the certificate of the secret
+// can't be parsed, isn't issued by the CA or is about to expire.
+func ServingCertNeedsRenewal(secret *corev1.Secret, ca *corev1.Secret, now time.Time) bool {
+	cert, _, err := parseTLSSecret(secret)
+	if err != nil {
+		return true
+	}
+
+	caCert, _, err := parseTLSSecret(ca)
+	if err != nil {
+		return true
+	}
+
+	if err := cert.CheckSignatureFrom(caCert); err != nil {
+		return true
+	}
+
+	return now.Add(servingCertRenewBefore).After(cert.NotAfter)
+}
+
+func (f *Factory) newTLSSecret(name string, certPEM []byte, key *rsa.PrivateKey) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: f.namespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey: certPEM,
+			corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{
+				Type:  "RSA PRIVATE KEY",
+				Bytes: x509.MarshalPKCS1PrivateKey(key),
+			}),
+		},
+	}
+}
+
+func newCertificate(
+	template, parent *x509.Certificate,
+	key, parentKey *rsa.PrivateKey,
+) ([]byte, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate serial number")
+	}
+
+	template.SerialNumber = serial
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create certificate")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+func parseTLSSecret(secret *corev1.Secret) (*x509.Certificate, *rsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if certBlock == nil {
+		return nil, nil, errors.New("no certificate found")
+	}
+
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse certificate")
+	}
+
+	keyBlock, _ := pem.Decode(secret.Data[corev1.TLSPrivateKeyKey])
+	if keyBlock == nil {
+		return nil, nil, errors.New("no private key found")
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse private key")
+	}
+
+	return cert, key, nil
+}
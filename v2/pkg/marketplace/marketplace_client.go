@@ -17,7 +17,6 @@ package marketplace
 import (
 	"bytes"
 	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	ioutil "io/ioutil"
@@ -30,6 +29,7 @@ import (
 
 	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/transport"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
 	status "github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/status"
 	corev1 "k8s.io/api/core/v1"
@@ -82,6 +82,7 @@ type MarketplaceClientBuilder struct {
 	Url        string
 	Insecure   bool
 	TlsOveride *tls.Config
+	Outbound   transport.Config
 }
 
 func NewMarketplaceClientBuilder(cfg *config.OperatorConfig) *MarketplaceClientBuilder {
@@ -97,10 +98,20 @@ func NewMarketplaceClientBuilder(cfg *config.OperatorConfig) *MarketplaceClientB
 	logger.V(2).Info("marketplace url set to", "url", builder.Url)
 
 	builder.Insecure = cfg.InsecureClient
+	builder.Outbound = cfg.Outbound
 
 	return builder
 }
 
+// SetOutbound sets the proxy and certificates of the client, i.e. after the
+// cluster proxy is read.
+func (b *MarketplaceClientBuilder) SetOutbound(outbound *transport.Config) *MarketplaceClientBuilder {
+	if outbound != nil {
+		b.Outbound = *outbound
+	}
+	return b
+}
+
 func (b *MarketplaceClientBuilder) SetTLSConfig(tlsConfig *tls.Config) *MarketplaceClientBuilder {
 	if tlsConfig != nil {
 		b.TlsOveride = tlsConfig
@@ -109,58 +120,33 @@ func (b *MarketplaceClientBuilder) SetTLSConfig(tlsConfig *tls.Config) *Marketpl
 }
 
 func (b *MarketplaceClientBuilder) NewMarketplaceClient(token string, tokenClaims *MarketplaceClaims) (*MarketplaceClient, error) {
-	var tlsConfig *tls.Config
 	marketplaceURL := b.Url
+	outbound := b.Outbound
 
-	if b.TlsOveride != nil {
-		logger.V(2).Info("using tls override")
-		marketplaceURL = b.Url
-		tlsConfig = b.TlsOveride
-
-		var transport http.RoundTripper = &http.Transport{
-			TLSClientConfig: tlsConfig,
-		}
-
-		if token != "" {
-			transport = WithBearerAuth(transport, token)
-		}
-		u, err := url.Parse(marketplaceURL)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to parse url")
-		}
-
-		return &MarketplaceClient{
-			endpoint: u,
-			httpClient: http.Client{
-				Transport: transport,
-			},
-		}, nil
-	}
-
-	if tokenClaims != nil &&
+	if b.TlsOveride == nil &&
+		tokenClaims != nil &&
 		strings.ToLower(tokenClaims.Env) == strings.ToLower(EnvStage) {
 		marketplaceURL = StageURL
 		logger.V(2).Info("using stage for marketplace url", "url", marketplaceURL)
 	}
 
 	if b.Insecure {
-		tlsConfig = &tls.Config{InsecureSkipVerify: true}
+		outbound.InsecureSkipVerify = true
 		logger.Info("using insecure client")
-	} else {
-		caCertPool, err := x509.SystemCertPool()
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get cert pool")
-		}
+	}
 
-		tlsConfig = &tls.Config{
-			RootCAs: caCertPool,
-		}
+	httpTransport, err := outbound.NewTransport()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build transport")
 	}
 
-	var transport http.RoundTripper = &http.Transport{
-		TLSClientConfig: tlsConfig,
+	if b.TlsOveride != nil {
+		logger.V(2).Info("using tls override")
+		httpTransport.TLSClientConfig = b.TlsOveride
 	}
 
+	var transport http.RoundTripper = httpTransport
+
 	if token != "" {
 		transport = WithBearerAuth(transport, token)
 	}
@@ -355,6 +341,27 @@ func (resp RegistrationStatusOutput) TransformConfigStatus() status.Conditions {
 }
 
 func (mhttp *MarketplaceClient) GetMarketplaceSecret() (*corev1.Secret, error) {
+	rhOperatorSecretDef, err := mhttp.getMarketplaceSecretDef()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseMarketplaceSecret(rhOperatorSecretDef)
+}
+
+// ParseMarketplaceSecret parses the rhm-operator-secret definition returned
+// by the pull secret endpoint.
+func ParseMarketplaceSecret(rhOperatorSecretDef []byte) (*corev1.Secret, error) {
+	newOptSecretObj := corev1.Secret{}
+	err := yaml.Unmarshal(rhOperatorSecretDef, &newOptSecretObj)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal secret")
+	}
+
+	return &newOptSecretObj, nil
+}
+
+func (mhttp *MarketplaceClient) getMarketplaceSecretDef() ([]byte, error) {
 	u, err := buildQuery(mhttp.endpoint, PullSecretEndpoint)
 
 	if err != nil {
@@ -376,13 +383,7 @@ func (mhttp *MarketplaceClient) GetMarketplaceSecret() (*corev1.Secret, error) {
 		return nil, errors.NewWithDetails("request not successful", "statuscode", resp.StatusCode)
 	}
 
-	newOptSecretObj := corev1.Secret{}
-	err = yaml.Unmarshal(rhOperatorSecretDef, &newOptSecretObj)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to unmarshal secret")
-	}
-
-	return &newOptSecretObj, nil
+	return rhOperatorSecretDef, nil
 }
 
 type MarketplaceClaims struct {
@@ -423,15 +424,25 @@ func (m *MarketplaceClient) getClusterObjID(account *MarketplaceClientAccount) (
 
 	logger.Info("get cluster objId query", "query", u.String())
 	resp, err := m.httpClient.Get(u.String())
-	clusterDef, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("failed to get cluster registration, status code %d", resp.StatusCode)
+	}
+
+	clusterDef, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return "", err
 	}
 
 	utils.PrettyPrint(string(clusterDef))
 	registrations, err := getRegistrations(string(clusterDef))
+	if err != nil {
+		return "", err
+	}
 
 	var objId string
 	for _, registration := range registrations {
@@ -453,8 +464,12 @@ func (m *MarketplaceClient) UnRegister(account *MarketplaceClientAccount) (Regis
 		return RegistrationStatusOutput{Err: err}, err
 	}
 
-	if err != nil {
-		return RegistrationStatusOutput{Err: err}, err
+	// a cluster that was never registered has nothing to unregister
+	if objID == "" {
+		return RegistrationStatusOutput{
+			StatusCode:         http.StatusOK,
+			RegistrationStatus: "UNREGISTERED",
+		}, nil
 	}
 
 	url := m.endpoint.String() + "/" + RegistrationEndpoint + "/" + objID
@@ -485,6 +500,7 @@ func (m *MarketplaceClient) UnRegister(account *MarketplaceClientAccount) (Regis
 		}, err
 	}
 	if resp.StatusCode != 200 {
+		err = errors.Errorf("failed to unregister, status code %d", resp.StatusCode)
 		return RegistrationStatusOutput{
 			RegistrationStatus: "HttpError",
 			Err:                err,
@@ -0,0 +1,153 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package marketplace
+
+import (
+	"emperror.dev/errors"
+	jwt "github.com/dgrijalva/jwt-go"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Offline registration exchanges files instead of calling the marketplace
+// from the cluster. The operator writes a registration request to the
+// request config map, an admin processes it on a connected host and
+// imports the response into the response secret.
+//
+// Both the request and the response are JWTs signed with the pull secret
+// token, only a holder of the token can produce a response the operator
+// accepts.
+const (
+	RegistrationRequestConfigMapName = "rhm-registration-request"
+	RegistrationResponseSecretName   = "rhm-registration-response"
+
+	RegistrationRequestKey     = "request"
+	RegistrationClusterUUIDKey = "clusterUuid"
+	RegistrationResponseKey    = "response"
+)
+
+// RegistrationRequestClaims identify the cluster to register.
+type RegistrationRequestClaims struct {
+	ClusterUUID string `json:"clusterUuid"`
+	AccountID   string `json:"rhmAccountId"`
+	Version     string `json:"version"`
+	jwt.StandardClaims
+}
+
+// RegistrationResponseClaims carry the rhm-operator-secret definition
+// returned by the pull secret endpoint for the registered cluster.
+type RegistrationResponseClaims struct {
+	ClusterUUID    string `json:"clusterUuid"`
+	AccountID      string `json:"rhmAccountId"`
+	OperatorSecret string `json:"operatorSecret"`
+	jwt.StandardClaims
+}
+
+// NewRegistrationRequest returns the registration request of the account
+// signed with the pull secret token.
+func NewRegistrationRequest(token string, account *MarketplaceClientAccount, version string) (string, error) {
+	claims := &RegistrationRequestClaims{
+		ClusterUUID: account.ClusterUuid,
+		AccountID:   account.AccountId,
+		Version:     version,
+	}
+
+	request, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(token))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign registration request")
+	}
+
+	return request, nil
+}
+
+// ParseRegistrationRequest verifies the request was signed with the pull
+// secret token and returns its claims.
+func ParseRegistrationRequest(token, request string) (*RegistrationRequestClaims, error) {
+	claims := &RegistrationRequestClaims{}
+	if err := parseSigned(token, request, claims); err != nil {
+		return nil, errors.Wrap(err, "invalid registration request")
+	}
+
+	if claims.ClusterUUID == "" || claims.AccountID == "" {
+		return nil, errors.New("registration request is missing the cluster or the account")
+	}
+
+	return claims, nil
+}
+
+// ProcessRegistrationRequest fetches the rhm-operator-secret for the
+// request and returns the signed response to import on the cluster. The
+// client must be built with the same pull secret token.
+func (mhttp *MarketplaceClient) ProcessRegistrationRequest(token, request string) (string, error) {
+	requestClaims, err := ParseRegistrationRequest(token, request)
+	if err != nil {
+		return "", err
+	}
+
+	tokenClaims, err := GetJWTTokenClaim(token)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse pull secret token")
+	}
+
+	if tokenClaims.AccountID != requestClaims.AccountID {
+		return "", errors.NewWithDetails("registration request is for another account",
+			"account", requestClaims.AccountID)
+	}
+
+	rhOperatorSecretDef, err := mhttp.getMarketplaceSecretDef()
+	if err != nil {
+		return "", err
+	}
+
+	claims := &RegistrationResponseClaims{
+		ClusterUUID:    requestClaims.ClusterUUID,
+		AccountID:      requestClaims.AccountID,
+		OperatorSecret: string(rhOperatorSecretDef),
+	}
+
+	response, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(token))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign registration response")
+	}
+
+	return response, nil
+}
+
+// ImportRegistrationResponse verifies the response was signed with the
+// pull secret token for the account and returns the rhm-operator-secret it
+// carries.
+func ImportRegistrationResponse(token, response string, account *MarketplaceClientAccount) (*corev1.Secret, error) {
+	claims := &RegistrationResponseClaims{}
+	if err := parseSigned(token, response, claims); err != nil {
+		return nil, errors.Wrap(err, "invalid registration response")
+	}
+
+	if claims.ClusterUUID != account.ClusterUuid || claims.AccountID != account.AccountId {
+		return nil, errors.NewWithDetails("registration response is for another cluster",
+			"clusterUuid", claims.ClusterUUID, "account", claims.AccountID)
+	}
+
+	return ParseMarketplaceSecret([]byte(claims.OperatorSecret))
+}
+
+func parseSigned(token, signed string, claims jwt.Claims) error {
+	_, err := jwt.ParseWithClaims(signed, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.NewWithDetails("unexpected signing method", "alg", t.Header["alg"])
+		}
+		return []byte(token), nil
+	})
+
+	return err
+}
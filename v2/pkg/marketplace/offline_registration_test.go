@@ -0,0 +1,122 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package marketplace
+
+import (
+	ioutil "io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+)
+
+var _ = Describe("Offline registration", func() {
+	var (
+		server  *httptest.Server
+		mclient *MarketplaceClient
+		token   string
+		account *MarketplaceClientAccount
+	)
+
+	newToken := func(accountID string) string {
+		signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &MarketplaceClaims{
+			AccountID: accountID,
+		}).SignedString([]byte("marketplace"))
+		Expect(err).To(Succeed())
+		return signed
+	}
+
+	BeforeEach(func() {
+		body, err := ioutil.ReadFile("../../tests/mockresponses/marketplace-pull-secret.yaml")
+		Expect(err).To(Succeed())
+
+		token = newToken("accountid")
+
+		// a fake marketplace that only serves the operator secret to the
+		// holder of the token
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/"+PullSecretEndpoint || req.Header.Get("Authorization") != "Bearer "+token {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write(body)
+		}))
+
+		cfg := &config.OperatorConfig{
+			Marketplace: config.Marketplace{
+				URL: server.URL,
+			},
+		}
+
+		mclient, err = NewMarketplaceClientBuilder(cfg).NewMarketplaceClient(token, &MarketplaceClaims{})
+		Expect(err).To(Succeed())
+
+		account = &MarketplaceClientAccount{
+			AccountId:   "accountid",
+			ClusterUuid: "cluster",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should exchange the operator secret through files", func() {
+		request, err := NewRegistrationRequest(token, account, "2.1.0")
+		Expect(err).To(Succeed())
+
+		claims, err := ParseRegistrationRequest(token, request)
+		Expect(err).To(Succeed())
+		Expect(claims.ClusterUUID).To(Equal("cluster"))
+		Expect(claims.AccountID).To(Equal("accountid"))
+		Expect(claims.Version).To(Equal("2.1.0"))
+
+		response, err := mclient.ProcessRegistrationRequest(token, request)
+		Expect(err).To(Succeed())
+
+		secret, err := ImportRegistrationResponse(token, response, account)
+		Expect(err).To(Succeed())
+
+		expected, err := mclient.GetMarketplaceSecret()
+		Expect(err).To(Succeed())
+		Expect(secret).To(Equal(expected))
+		Expect(secret.Name).To(Equal("rhm-operator-secret"))
+	})
+
+	It("should reject a request signed with another token", func() {
+		request, err := NewRegistrationRequest(newToken("other"), account, "2.1.0")
+		Expect(err).To(Succeed())
+
+		_, err = mclient.ProcessRegistrationRequest(token, request)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject a response for another cluster", func() {
+		request, err := NewRegistrationRequest(token, &MarketplaceClientAccount{
+			AccountId:   "accountid",
+			ClusterUuid: "other",
+		}, "2.1.0")
+		Expect(err).To(Succeed())
+
+		response, err := mclient.ProcessRegistrationRequest(token, request)
+		Expect(err).To(Succeed())
+
+		_, err = ImportRegistrationResponse(token, response, account)
+		Expect(err).To(MatchError(ContainSubstring("another cluster")))
+	})
+})
@@ -0,0 +1,199 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"crypto/tls"
+
+	"emperror.dev/errors"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/transport"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewExternalPromAPI creates the api for an external Prometheus compatible
+// query endpoint. Secrets and config maps referenced by the endpoint are
+// read from namespace.
+func NewExternalPromAPI(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	external *common.ExternalPrometheus,
+) (*PrometheusAPI, error) {
+	config, err := LoadExternalPrometheusConfig(ctx, c, namespace, external)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := NewSecureClientFromCert(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrometheusAPI{API: v1.NewAPI(conf), client: conf}, nil
+}
+
+// LoadExternalPrometheusConfig resolves the credentials and certificates of
+// an external endpoint into a client configuration.
+func LoadExternalPrometheusConfig(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	external *common.ExternalPrometheus,
+) (*PrometheusSecureClientConfig, error) {
+	if external == nil || external.URL == "" {
+		return nil, errors.New("external prometheus url not defined")
+	}
+
+	// external endpoints may be outside of the cluster, they are reached
+	// through the proxy and trust the trusted ca bundle
+	outbound, err := transport.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	loader := &secretLoader{ctx: ctx, client: c, namespace: namespace}
+	config := &PrometheusSecureClientConfig{
+		Address: external.URL,
+		Outbound: transport.Config{
+			HTTPProxy:  outbound.HTTPProxy,
+			HTTPSProxy: outbound.HTTPSProxy,
+			NoProxy:    outbound.NoProxy,
+			CAFiles:    outbound.CAFiles,
+		},
+	}
+
+	if err := config.Outbound.WithClusterProxy(ctx, c); err != nil {
+		return nil, err
+	}
+
+	if external.BearerTokenSecret != nil {
+		token, err := loader.secretKey(*external.BearerTokenSecret)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load bearer token")
+		}
+		config.Token = string(token)
+	}
+
+	if external.BasicAuth != nil {
+		username, err := loader.secretKey(external.BasicAuth.Username)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load basic auth username")
+		}
+
+		password, err := loader.secretKey(external.BasicAuth.Password)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load basic auth password")
+		}
+
+		config.UserAuth = &UserAuth{Username: string(username), Password: string(password)}
+	}
+
+	if tlsConfig := external.TLSConfig; tlsConfig != nil {
+		if err := tlsConfig.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid tls config")
+		}
+
+		config.ServerName = tlsConfig.ServerName
+		config.InsecureSkipVerify = tlsConfig.InsecureSkipVerify
+
+		if tlsConfig.CA != (monitoringv1.SecretOrConfigMap{}) {
+			ca, err := loader.secretOrConfigMapKey(tlsConfig.CA)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to load ca bundle")
+			}
+			config.CaCert = &ca
+		}
+
+		if tlsConfig.Cert != (monitoringv1.SecretOrConfigMap{}) {
+			if tlsConfig.KeySecret == nil {
+				return nil, errors.New("client certificate requires a key secret")
+			}
+
+			cert, err := loader.secretOrConfigMapKey(tlsConfig.Cert)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to load client certificate")
+			}
+
+			key, err := loader.secretKey(*tlsConfig.KeySecret)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to load client key")
+			}
+
+			clientCert, err := tls.X509KeyPair(cert, key)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse client certificate")
+			}
+			config.ClientCert = &clientCert
+		}
+	}
+
+	return config, nil
+}
+
+type secretLoader struct {
+	ctx       context.Context
+	client    client.Reader
+	namespace string
+}
+
+func (l *secretLoader) secretKey(sel corev1.SecretKeySelector) ([]byte, error) {
+	secret := &corev1.Secret{}
+
+	if err := l.client.Get(l.ctx, types.NamespacedName{Name: sel.Name, Namespace: l.namespace}, secret); err != nil {
+		return nil, errors.WrapWithDetails(err, "failed to get secret", "name", sel.Name)
+	}
+
+	data, ok := secret.Data[sel.Key]
+	if !ok {
+		return nil, errors.NewWithDetails("key not found in secret", "name", sel.Name, "key", sel.Key)
+	}
+
+	return data, nil
+}
+
+func (l *secretLoader) configMapKey(sel corev1.ConfigMapKeySelector) ([]byte, error) {
+	cm := &corev1.ConfigMap{}
+
+	if err := l.client.Get(l.ctx, types.NamespacedName{Name: sel.Name, Namespace: l.namespace}, cm); err != nil {
+		return nil, errors.WrapWithDetails(err, "failed to get configmap", "name", sel.Name)
+	}
+
+	if data, ok := cm.Data[sel.Key]; ok {
+		return []byte(data), nil
+	}
+
+	if data, ok := cm.BinaryData[sel.Key]; ok {
+		return data, nil
+	}
+
+	return nil, errors.NewWithDetails("key not found in configmap", "name", sel.Name, "key", sel.Key)
+}
+
+func (l *secretLoader) secretOrConfigMapKey(sel monitoringv1.SecretOrConfigMap) ([]byte, error) {
+	switch {
+	case sel.Secret != nil:
+		return l.secretKey(*sel.Secret)
+	case sel.ConfigMap != nil:
+		return l.configMapKey(*sel.ConfigMap)
+	default:
+		return nil, errors.New("secret or configmap not defined")
+	}
+}
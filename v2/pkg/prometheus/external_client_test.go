@@ -0,0 +1,134 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ExternalPrometheus", func() {
+	var (
+		namespace = "openshift-redhat-marketplace"
+		k8sClient client.Client
+		external  *common.ExternalPrometheus
+	)
+
+	BeforeEach(func() {
+		k8sClient = fake.NewFakeClientWithScheme(scheme.Scheme,
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "thanos-auth", Namespace: namespace},
+				Data: map[string][]byte{
+					"token":    []byte("mytoken"),
+					"username": []byte("user"),
+					"password": []byte("pass"),
+				},
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "thanos-ca", Namespace: namespace},
+				Data: map[string]string{
+					"ca-bundle.crt": "cert",
+				},
+			},
+		)
+
+		external = &common.ExternalPrometheus{
+			URL: "https://thanos-querier.openshift-monitoring.svc:9091",
+		}
+	})
+
+	It("should load the bearer token and ca bundle", func() {
+		external.BearerTokenSecret = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-auth"},
+			Key:                  "token",
+		}
+		external.TLSConfig = &monitoringv1.SafeTLSConfig{
+			CA: monitoringv1.SecretOrConfigMap{
+				ConfigMap: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-ca"},
+					Key:                  "ca-bundle.crt",
+				},
+			},
+			ServerName: "thanos-querier",
+		}
+
+		config, err := LoadExternalPrometheusConfig(context.TODO(), k8sClient, namespace, external)
+
+		Expect(err).To(Succeed())
+		Expect(config.Address).To(Equal(external.URL))
+		Expect(config.Token).To(Equal("mytoken"))
+		Expect(config.CaCert).ToNot(BeNil())
+		Expect(string(*config.CaCert)).To(Equal("cert"))
+		Expect(config.ServerName).To(Equal("thanos-querier"))
+		Expect(config.UserAuth).To(BeNil())
+		Expect(config.ClientCert).To(BeNil())
+	})
+
+	It("should load basic auth", func() {
+		external.BasicAuth = &monitoringv1.BasicAuth{
+			Username: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-auth"},
+				Key:                  "username",
+			},
+			Password: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-auth"},
+				Key:                  "password",
+			},
+		}
+
+		config, err := LoadExternalPrometheusConfig(context.TODO(), k8sClient, namespace, external)
+
+		Expect(err).To(Succeed())
+		Expect(config.UserAuth).To(Equal(&UserAuth{Username: "user", Password: "pass"}))
+
+		api, err := NewExternalPromAPI(context.TODO(), k8sClient, namespace, external)
+		Expect(err).To(Succeed())
+		Expect(api).ToNot(BeNil())
+	})
+
+	It("should fail on missing keys", func() {
+		external.BearerTokenSecret = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-auth"},
+			Key:                  "missing",
+		}
+
+		_, err := LoadExternalPrometheusConfig(context.TODO(), k8sClient, namespace, external)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failed to load bearer token"))
+	})
+
+	It("should require a key for a client certificate", func() {
+		external.TLSConfig = &monitoringv1.SafeTLSConfig{
+			Cert: monitoringv1.SecretOrConfigMap{
+				Secret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "thanos-auth"},
+					Key:                  "token",
+				},
+			},
+		}
+
+		_, err := LoadExternalPrometheusConfig(context.TODO(), k8sClient, namespace, external)
+		Expect(err).To(HaveOccurred())
+	})
+})
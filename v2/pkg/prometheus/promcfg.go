@@ -35,7 +35,7 @@ const (
 	kubernetesSDRoleEndpoint        = "endpoints"
 	kubernetesSDRolePod             = "pod"
 	kubernetesSDRoleIngress         = "ingress"
-	defaultReplicaExternalLabelName = "prometheus_replica"
+	defaultReplicaExternalLabelName = ReplicaExternalLabelName
 	confOutDir                      = "/etc/prometheus/config_out"
 	tlsAssetsDir                    = "/etc/prometheus/certs"
 	rulesDir                        = "/etc/prometheus/rules"
@@ -70,6 +70,7 @@ func honorLabels(userHonorLabels, overrideHonorLabels bool) bool {
 func (cg *configGenerator) GenerateConfig(
 	p *v1.Prometheus,
 	sMons map[string]*v1.ServiceMonitor,
+	pMons map[string]*v1.PodMonitor,
 	basicAuthSecrets map[string]assets.BasicAuthCredentials,
 	bearerTokens map[string]assets.BearerToken,
 	ruleConfigMapNames []string,
@@ -129,6 +130,16 @@ func (cg *configGenerator) GenerateConfig(
 	// Sorting ensures, that we always generate the config in the same order.
 	sort.Strings(sMonIdentifiers)
 
+	pMonIdentifiers := make([]string, len(pMons))
+	i = 0
+	for k := range pMons {
+		pMonIdentifiers[i] = k
+		i++
+	}
+
+	// Sorting ensures, that we always generate the config in the same order.
+	sort.Strings(pMonIdentifiers)
+
 	apiserverConfig := p.Spec.APIServerConfig
 
 	var scrapeConfigs []yaml.MapSlice
@@ -144,7 +155,26 @@ func (cg *configGenerator) GenerateConfig(
 					bearerTokens,
 					p.Spec.OverrideHonorLabels,
 					p.Spec.OverrideHonorTimestamps,
-					p.Spec.IgnoreNamespaceSelectors))
+					p.Spec.IgnoreNamespaceSelectors,
+					p.Spec.EnforcedSampleLimit,
+					p.Spec.EnforcedTargetLimit))
+		}
+	}
+	for _, identifier := range pMonIdentifiers {
+		for i, ep := range pMons[identifier].Spec.PodMetricsEndpoints {
+			scrapeConfigs = append(scrapeConfigs,
+				cg.generatePodMonitorConfig(
+					version,
+					pMons[identifier],
+					ep, i,
+					apiserverConfig,
+					basicAuthSecrets,
+					bearerTokens,
+					p.Spec.OverrideHonorLabels,
+					p.Spec.OverrideHonorTimestamps,
+					p.Spec.IgnoreNamespaceSelectors,
+					p.Spec.EnforcedSampleLimit,
+					p.Spec.EnforcedTargetLimit))
 		}
 	}
 
@@ -203,6 +233,258 @@ func addTLStoYaml(cfg yaml.MapSlice, namespace string, tls *v1.TLSConfig) yaml.M
 	return cfg
 }
 
+func addSafeTLStoYaml(cfg yaml.MapSlice, namespace string, tls v1.SafeTLSConfig) yaml.MapSlice {
+	pathPrefix := path.Join(tlsAssetsDir, namespace)
+	tlsConfig := yaml.MapSlice{
+		{Key: "insecure_skip_verify", Value: tls.InsecureSkipVerify},
+	}
+	if tls.CA.Secret != nil {
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "ca_file", Value: pathPrefix + "_" + tls.CA.Secret.Name + "_" + tls.CA.Secret.Key})
+	}
+	if tls.CA.ConfigMap != nil {
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "ca_file", Value: pathPrefix + "_" + tls.CA.ConfigMap.Name + "_" + tls.CA.ConfigMap.Key})
+	}
+	if tls.Cert.Secret != nil {
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "cert_file", Value: pathPrefix + "_" + tls.Cert.Secret.Name + "_" + tls.Cert.Secret.Key})
+	}
+	if tls.Cert.ConfigMap != nil {
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "cert_file", Value: pathPrefix + "_" + tls.Cert.ConfigMap.Name + "_" + tls.Cert.ConfigMap.Key})
+	}
+	if tls.KeySecret != nil {
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "key_file", Value: pathPrefix + "_" + tls.KeySecret.Name + "_" + tls.KeySecret.Key})
+	}
+	if tls.ServerName != "" {
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "server_name", Value: tls.ServerName})
+	}
+	return append(cfg, yaml.MapItem{Key: "tls_config", Value: tlsConfig})
+}
+
+func (cg *configGenerator) generatePodMonitorConfig(
+	version semver.Version,
+	m *v1.PodMonitor,
+	ep v1.PodMetricsEndpoint,
+	i int,
+	apiserverConfig *v1.APIServerConfig,
+	basicAuthSecrets map[string]assets.BasicAuthCredentials,
+	bearerTokens map[string]assets.BearerToken,
+	overrideHonorLabels bool,
+	overrideHonorTimestamps bool,
+	ignoreNamespaceSelectors bool,
+	enforcedSampleLimit *uint64,
+	enforcedTargetLimit *uint64) yaml.MapSlice {
+
+	hl := honorLabels(ep.HonorLabels, overrideHonorLabels)
+	cfg := yaml.MapSlice{
+		{
+			// prefixed so a pod monitor does not collide with a service
+			// monitor of the same name
+			Key:   "job_name",
+			Value: fmt.Sprintf("podMonitor/%s/%s/%d", m.Namespace, m.Name, i),
+		},
+		{
+			Key:   "honor_labels",
+			Value: hl,
+		},
+	}
+	if version.Major == 2 && version.Minor >= 9 {
+		cfg = honorTimestamps(cfg, ep.HonorTimestamps, overrideHonorTimestamps)
+	}
+
+	if version.Major == 1 && version.Minor < 7 {
+		if apiserverConfig != nil {
+			cg.logger.Info("custom apiserver config is set but it will not take effect because prometheus version is < 1.7")
+		}
+		cfg = append(cfg, cg.generateK8SSDConfig(nil, nil, nil, kubernetesSDRolePod))
+	} else {
+		selectedNamespaces := getNamespacesFromNamespaceSelector(&m.Spec.NamespaceSelector, m.Namespace, ignoreNamespaceSelectors)
+		cfg = append(cfg, cg.generateK8SSDConfig(selectedNamespaces, apiserverConfig, basicAuthSecrets, kubernetesSDRolePod))
+	}
+
+	if ep.Interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: ep.Interval})
+	}
+	if ep.ScrapeTimeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: ep.ScrapeTimeout})
+	}
+	if ep.Path != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: ep.Path})
+	}
+	if ep.ProxyURL != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "proxy_url", Value: ep.ProxyURL})
+	}
+	if ep.Params != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "params", Value: ep.Params})
+	}
+	if ep.Scheme != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: ep.Scheme})
+	}
+
+	if ep.TLSConfig != nil {
+		cfg = addSafeTLStoYaml(cfg, m.Namespace, ep.TLSConfig.SafeTLSConfig)
+	}
+
+	if ep.BearerTokenSecret.Name != "" {
+		if s, ok := bearerTokens[fmt.Sprintf("podMonitor/%s/%s/%d", m.Namespace, m.Name, i)]; ok {
+			cfg = append(cfg, yaml.MapItem{Key: "bearer_token", Value: s})
+		}
+	}
+
+	if ep.BasicAuth != nil {
+		if s, ok := basicAuthSecrets[fmt.Sprintf("podMonitor/%s/%s/%d", m.Namespace, m.Name, i)]; ok {
+			cfg = append(cfg, yaml.MapItem{
+				Key: "basic_auth", Value: yaml.MapSlice{
+					{Key: "username", Value: s.Username},
+					{Key: "password", Value: s.Password},
+				},
+			})
+		}
+	}
+
+	var relabelings []yaml.MapSlice
+
+	// Filter targets by pods selected by the monitor.
+
+	// Exact label matches.
+	var labelKeys []string
+	for k := range m.Spec.Selector.MatchLabels {
+		labelKeys = append(labelKeys, k)
+	}
+	sort.Strings(labelKeys)
+
+	for _, k := range labelKeys {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "action", Value: "keep"},
+			{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(k)}},
+			{Key: "regex", Value: m.Spec.Selector.MatchLabels[k]},
+		})
+	}
+	// Set based label matching. We have to map the valid relations
+	// `In`, `NotIn`, `Exists`, and `DoesNotExist`, into relabeling rules.
+	for _, exp := range m.Spec.Selector.MatchExpressions {
+		switch exp.Operator {
+		case metav1.LabelSelectorOpIn:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "keep"},
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: strings.Join(exp.Values, "|")},
+			})
+		case metav1.LabelSelectorOpNotIn:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "drop"},
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: strings.Join(exp.Values, "|")},
+			})
+		case metav1.LabelSelectorOpExists:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "keep"},
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_labelpresent_" + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: "true"},
+			})
+		case metav1.LabelSelectorOpDoesNotExist:
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "drop"},
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_labelpresent_" + sanitizeLabelName(exp.Key)}},
+				{Key: "regex", Value: "true"},
+			})
+		}
+	}
+
+	// Filter targets based on correct port for the endpoint.
+	if ep.Port != "" {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "action", Value: "keep"},
+			{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_container_port_name"}},
+			{Key: "regex", Value: ep.Port},
+		})
+	} else if ep.TargetPort != nil {
+		if ep.TargetPort.StrVal != "" {
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "keep"},
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_container_port_name"}},
+				{Key: "regex", Value: ep.TargetPort.String()},
+			})
+		} else if ep.TargetPort.IntVal != 0 {
+			relabelings = append(relabelings, yaml.MapSlice{
+				{Key: "action", Value: "keep"},
+				{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_container_port_number"}},
+				{Key: "regex", Value: ep.TargetPort.String()},
+			})
+		}
+	}
+
+	// Relabel namespace and pod and container labels into proper labels.
+	relabelings = append(relabelings, []yaml.MapSlice{
+		{
+			{Key: "source_labels", Value: []string{"__meta_kubernetes_namespace"}},
+			{Key: "target_label", Value: "namespace"},
+		},
+		{
+			{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_container_name"}},
+			{Key: "target_label", Value: "container"},
+		},
+		{
+			{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_name"}},
+			{Key: "target_label", Value: "pod"},
+		},
+	}...)
+
+	// Relabel targetLabels from Pod onto target.
+	for _, l := range m.Spec.PodTargetLabels {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(l)}},
+			{Key: "target_label", Value: sanitizeLabelName(l)},
+			{Key: "regex", Value: "(.+)"},
+			{Key: "replacement", Value: "${1}"},
+		})
+	}
+
+	// By default, generate a safe job name from the PodMonitor. We also keep
+	// this around if a jobLabel is set in case the targets don't actually have a
+	// value for it.
+	relabelings = append(relabelings, yaml.MapSlice{
+		{Key: "target_label", Value: "job"},
+		{Key: "replacement", Value: fmt.Sprintf("%s/%s", m.GetNamespace(), m.GetName())},
+	})
+	if m.Spec.JobLabel != "" {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "source_labels", Value: []string{"__meta_kubernetes_pod_label_" + sanitizeLabelName(m.Spec.JobLabel)}},
+			{Key: "target_label", Value: "job"},
+			{Key: "regex", Value: "(.+)"},
+			{Key: "replacement", Value: "${1}"},
+		})
+	}
+
+	if ep.Port != "" {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "target_label", Value: "endpoint"},
+			{Key: "replacement", Value: ep.Port},
+		})
+	} else if ep.TargetPort != nil && ep.TargetPort.String() != "" {
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "target_label", Value: "endpoint"},
+			{Key: "replacement", Value: ep.TargetPort.String()},
+		})
+	}
+
+	if ep.RelabelConfigs != nil {
+		for _, c := range ep.RelabelConfigs {
+			relabelings = append(relabelings, generateRelabelConfig(c))
+		}
+	}
+	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
+	cfg = addLimitsToYaml(cfg, version, m.Spec.SampleLimit, m.Spec.TargetLimit, enforcedSampleLimit, enforcedTargetLimit)
+
+	if ep.MetricRelabelConfigs != nil {
+		var metricRelabelings []yaml.MapSlice
+		for _, c := range ep.MetricRelabelConfigs {
+			metricRelabelings = append(metricRelabelings, generateRelabelConfig(c))
+		}
+		cfg = append(cfg, yaml.MapItem{Key: "metric_relabel_configs", Value: metricRelabelings})
+	}
+
+	return cfg
+}
+
 func (cg *configGenerator) generateServiceMonitorConfig(
 	version semver.Version,
 	m *v1.ServiceMonitor,
@@ -213,7 +495,9 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 	bearerTokens map[string]assets.BearerToken,
 	overrideHonorLabels bool,
 	overrideHonorTimestamps bool,
-	ignoreNamespaceSelectors bool) yaml.MapSlice {
+	ignoreNamespaceSelectors bool,
+	enforcedSampleLimit *uint64,
+	enforcedTargetLimit *uint64) yaml.MapSlice {
 
 	hl := honorLabels(ep.HonorLabels, overrideHonorLabels)
 	cfg := yaml.MapSlice{
@@ -445,6 +729,7 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 		}
 	}
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
+	cfg = addLimitsToYaml(cfg, version, m.Spec.SampleLimit, m.Spec.TargetLimit, enforcedSampleLimit, enforcedTargetLimit)
 
 	if ep.MetricRelabelConfigs != nil {
 		var metricRelabelings []yaml.MapSlice
@@ -459,6 +744,36 @@ func (cg *configGenerator) generateServiceMonitorConfig(
 	return cfg
 }
 
+// addLimitsToYaml sets the sample and target limits of a monitor. The
+// enforced limits of the Prometheus win if they are lower. Target limits
+// need Prometheus 2.21.
+func addLimitsToYaml(
+	cfg yaml.MapSlice,
+	version semver.Version,
+	sampleLimit, targetLimit uint64,
+	enforcedSampleLimit, enforcedTargetLimit *uint64,
+) yaml.MapSlice {
+	if sampleLimit > 0 || enforcedSampleLimit != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "sample_limit", Value: getLimit(sampleLimit, enforcedSampleLimit)})
+	}
+
+	if version.GTE(semver.MustParse("2.21.0")) && (targetLimit > 0 || enforcedTargetLimit != nil) {
+		cfg = append(cfg, yaml.MapItem{Key: "target_limit", Value: getLimit(targetLimit, enforcedTargetLimit)})
+	}
+
+	return cfg
+}
+
+func getLimit(user uint64, enforced *uint64) uint64 {
+	if enforced != nil {
+		if user < *enforced && user != 0 || *enforced == 0 {
+			return user
+		}
+		return *enforced
+	}
+	return user
+}
+
 // getNamespacesFromNamespaceSelector gets a list of namespaces to select based on
 // the given namespace selector, the given default namespace, and whether to ignore namespace selectors
 func getNamespacesFromNamespaceSelector(nsel *v1.NamespaceSelector, namespace string, ignoreNamespaceSelectors bool) []string {
@@ -534,7 +849,7 @@ func buildExternalLabels(p *v1.Prometheus) yaml.MapSlice {
 
 	// Use "prometheus" external label name by default if field is missing.
 	// Do not add external label if field is set to empty string.
-	prometheusExternalLabelName := "prometheus"
+	prometheusExternalLabelName := PrometheusExternalLabelName
 	if p.Spec.PrometheusExternalLabelName != nil {
 		if *p.Spec.PrometheusExternalLabelName != "" {
 			prometheusExternalLabelName = *p.Spec.PrometheusExternalLabelName
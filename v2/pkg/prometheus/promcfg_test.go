@@ -0,0 +1,195 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/gotidy/ptr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus/prometheus/config"
+	_ "github.com/prometheus/prometheus/discovery/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var updateGolden = flag.Bool("update", false, "update the promcfg golden files")
+
+var _ = Describe("ConfigGenerator", func() {
+	type configCase struct {
+		prometheus       *monitoringv1.Prometheus
+		sMons            map[string]*monitoringv1.ServiceMonitor
+		pMons            map[string]*monitoringv1.PodMonitor
+		basicAuthSecrets map[string]assets.BasicAuthCredentials
+		bearerTokens     map[string]assets.BearerToken
+	}
+
+	newPrometheus := func() *monitoringv1.Prometheus {
+		return &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rhm-marketplaceconfig-meterbase",
+				Namespace: "openshift-redhat-marketplace",
+			},
+		}
+	}
+
+	kubeStateMonitor := &monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "kube-state-metrics", Namespace: "openshift-monitoring"},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			JobLabel:    "app.kubernetes.io/name",
+			SampleLimit: 100000,
+			TargetLimit: 10,
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"app.kubernetes.io/name": "kube-state-metrics"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "app.kubernetes.io/component", Operator: metav1.LabelSelectorOpIn, Values: []string{"exporter"}},
+					{Key: "canary", Operator: metav1.LabelSelectorOpDoesNotExist},
+				},
+			},
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					Port:            "https-main",
+					Scheme:          "https",
+					Interval:        "1m",
+					ScrapeTimeout:   "30s",
+					HonorLabels:     true,
+					BearerTokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token",
+					ProxyURL:        ptr.String("http://proxy.example.com:3128"),
+					Params:          map[string][]string{"collect[]": {"pods"}},
+					TLSConfig: &monitoringv1.TLSConfig{
+						CAFile: "/etc/prometheus/configmaps/serving-certs-ca-bundle/service-ca.crt",
+						SafeTLSConfig: monitoringv1.SafeTLSConfig{
+							ServerName: "kube-state-metrics.openshift-monitoring.svc",
+						},
+					},
+					RelabelConfigs: []*monitoringv1.RelabelConfig{
+						{Action: "labeldrop", Regex: "pod"},
+					},
+					MetricRelabelConfigs: []*monitoringv1.RelabelConfig{
+						{SourceLabels: []string{"__name__"}, Regex: "kube_pod_info|kube_persistentvolumeclaim_info", Action: "keep"},
+					},
+				},
+			},
+		},
+	}
+
+	vendorPodMonitor := &monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "vendor", Namespace: "vendor-ns"},
+		Spec: monitoringv1.PodMonitorSpec{
+			PodTargetLabels: []string{"app"},
+			SampleLimit:     5000,
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "vendor"},
+			},
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+				{
+					Port:   "metrics",
+					Path:   "/custom/metrics",
+					Scheme: "https",
+					TLSConfig: &monitoringv1.PodMetricsEndpointTLSConfig{
+						SafeTLSConfig: monitoringv1.SafeTLSConfig{
+							CA: monitoringv1.SecretOrConfigMap{
+								ConfigMap: &corev1.ConfigMapKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "vendor-ca"},
+									Key:                  "ca.crt",
+								},
+							},
+							InsecureSkipVerify: true,
+						},
+					},
+					BasicAuth: &monitoringv1.BasicAuth{
+						Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "vendor-auth"}, Key: "user"},
+						Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "vendor-auth"}, Key: "password"},
+					},
+				},
+				{
+					TargetPort: &intstr.IntOrString{IntVal: 8081},
+					BearerTokenSecret: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "vendor-token"},
+						Key:                  "token",
+					},
+				},
+			},
+		},
+	}
+
+	DescribeTable("should generate configs the Prometheus config loader accepts",
+		func(golden string, c configCase) {
+			if c.sMons == nil {
+				c.sMons = map[string]*monitoringv1.ServiceMonitor{}
+			}
+			if c.pMons == nil {
+				c.pMons = map[string]*monitoringv1.PodMonitor{}
+			}
+
+			cfg, err := NewConfigGenerator(logf.Log).GenerateConfig(
+				c.prometheus, c.sMons, c.pMons, c.basicAuthSecrets, c.bearerTokens, []string{})
+			Expect(err).To(Succeed())
+
+			_, err = config.Load("scrape_configs:\n" + string(cfg))
+			Expect(err).To(Succeed())
+
+			goldenFile := filepath.Join("testdata", "promcfg", golden)
+			if *updateGolden {
+				Expect(ioutil.WriteFile(goldenFile, cfg, 0644)).To(Succeed())
+			}
+
+			expected, err := ioutil.ReadFile(goldenFile)
+			Expect(err).To(Succeed())
+			Expect(string(cfg)).To(MatchYAML(string(expected)))
+		},
+		Entry("service monitor", "service_monitor.golden.yaml", configCase{
+			prometheus: newPrometheus(),
+			sMons:      map[string]*monitoringv1.ServiceMonitor{"kube-state": kubeStateMonitor},
+		}),
+		Entry("pod monitor", "pod_monitor.golden.yaml", configCase{
+			prometheus: newPrometheus(),
+			pMons:      map[string]*monitoringv1.PodMonitor{"vendor": vendorPodMonitor},
+			basicAuthSecrets: map[string]assets.BasicAuthCredentials{
+				"podMonitor/vendor-ns/vendor/0": {Username: "user", Password: "password"},
+			},
+			bearerTokens: map[string]assets.BearerToken{
+				"podMonitor/vendor-ns/vendor/1": "token",
+			},
+		}),
+		Entry("enforced limits", "enforced_limits.golden.yaml", configCase{
+			prometheus: func() *monitoringv1.Prometheus {
+				p := newPrometheus()
+				p.Spec.EnforcedSampleLimit = ptr.UInt64(50000)
+				p.Spec.EnforcedTargetLimit = ptr.UInt64(100)
+				p.Spec.OverrideHonorLabels = true
+				return p
+			}(),
+			sMons: map[string]*monitoringv1.ServiceMonitor{"kube-state": kubeStateMonitor},
+			pMons: map[string]*monitoringv1.PodMonitor{"vendor": vendorPodMonitor},
+		}),
+		Entry("prometheus without target limits", "old_version.golden.yaml", configCase{
+			prometheus: func() *monitoringv1.Prometheus {
+				p := newPrometheus()
+				p.Spec.Version = "v2.20.0"
+				return p
+			}(),
+			sMons: map[string]*monitoringv1.ServiceMonitor{"kube-state": kubeStateMonitor},
+		}),
+	)
+})
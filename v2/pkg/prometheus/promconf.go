@@ -28,6 +28,7 @@ import (
 func LoadBasicAuthSecrets(
 	client client.Client,
 	mons map[string]*monitoringv1.ServiceMonitor,
+	pMons map[string]*monitoringv1.PodMonitor,
 	remoteReads []monitoringv1.RemoteReadSpec,
 	remoteWrites []monitoringv1.RemoteWriteSpec,
 	apiserverConfig *monitoringv1.APIServerConfig,
@@ -49,6 +50,18 @@ func LoadBasicAuthSecrets(
 		}
 	}
 
+	for _, mon := range pMons {
+		for i, ep := range mon.Spec.PodMetricsEndpoints {
+			if ep.BasicAuth != nil {
+				credentials, err := loadBasicAuthSecretFromAPI(ep.BasicAuth, client, mon.Namespace, nsSecretCache)
+				if err != nil {
+					return nil, fmt.Errorf("could not generate basicAuth for podmonitor %s. %s", mon.Name, err)
+				}
+				secrets[fmt.Sprintf("podMonitor/%s/%s/%d", mon.Namespace, mon.Name, i)] = credentials
+			}
+		}
+	}
+
 	for i, remote := range remoteReads {
 		if remote.BasicAuth != nil {
 			credentials, err := loadBasicAuthSecret(remote.BasicAuth, SecretsInPromNS)
@@ -149,7 +162,11 @@ func getCredFromSecret(c client.Client, sel corev1.SecretKeySelector, namespace
 	return extractCredKey(s, sel, cred)
 }
 
-func LoadBearerTokensFromSecrets(client client.Client, mons map[string]*monitoringv1.ServiceMonitor) (map[string]assets.BearerToken, error) {
+func LoadBearerTokensFromSecrets(
+	client client.Client,
+	mons map[string]*monitoringv1.ServiceMonitor,
+	pMons map[string]*monitoringv1.PodMonitor,
+) (map[string]assets.BearerToken, error) {
 	tokens := map[string]assets.BearerToken{}
 	nsSecretCache := make(map[string]*corev1.Secret)
 
@@ -178,5 +195,30 @@ func LoadBearerTokensFromSecrets(client client.Client, mons map[string]*monitori
 		}
 	}
 
+	for _, mon := range pMons {
+		for i, ep := range mon.Spec.PodMetricsEndpoints {
+			if ep.BearerTokenSecret.Name == "" {
+				continue
+			}
+
+			token, err := getCredFromSecret(
+				client,
+				ep.BearerTokenSecret,
+				"bearertoken",
+				mon.GetNamespace(),
+				mon.Namespace+"/"+ep.BearerTokenSecret.Name,
+				nsSecretCache,
+			)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"failed to extract endpoint bearertoken for podmonitor %v from secret %v in namespace %v",
+					mon.Name, ep.BearerTokenSecret.Name, mon.Namespace,
+				)
+			}
+
+			tokens[fmt.Sprintf("podMonitor/%s/%s/%d", mon.Namespace, mon.Name, i)] = assets.BearerToken(token)
+		}
+	}
+
 	return tokens, nil
 }
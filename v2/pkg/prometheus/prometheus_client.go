@@ -27,6 +27,7 @@ import (
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/log"
 	v1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/transport"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -42,6 +43,16 @@ type PrometheusSecureClientConfig struct {
 	ServerCertFile string
 
 	CaCert *[]byte
+
+	ClientCert *tls.Certificate
+
+	ServerName string
+
+	InsecureSkipVerify bool
+
+	// Outbound is the proxy and the trusted certificates the client starts
+	// from, in-cluster clients connect directly.
+	Outbound transport.Config
 }
 
 type UserAuth struct {
@@ -54,6 +65,10 @@ type PrometheusAPISetup struct {
 	CertFilePath  string
 	TokenFilePath string
 	RunLocal      bool
+	External      *PrometheusSecureClientConfig
+	// Replicas are the addresses of the Prometheus pods behind the service.
+	// Each replica is queried if there is more than one.
+	Replicas []string
 }
 
 func NewPromAPI(
@@ -61,11 +76,11 @@ func NewPromAPI(
 	caCert *[]byte,
 	token string,
 ) (*PrometheusAPI, error) {
-	promAPI, err := providePrometheusAPI(promService, caCert, token)
+	client, err := providePrometheusClient(promService, caCert, token)
 	if err != nil {
 		return nil, err
 	}
-	prometheusAPI := &PrometheusAPI{promAPI}
+	prometheusAPI := &PrometheusAPI{API: v1.NewAPI(client), client: client}
 	return prometheusAPI, nil
 }
 
@@ -76,15 +91,15 @@ func NewPrometheusAPIForReporter(
 	if err != nil {
 		return nil, err
 	}
-	prometheusAPI := &PrometheusAPI{promAPI}
+	prometheusAPI := &PrometheusAPI{API: promAPI}
 	return prometheusAPI, nil
 }
 
-func providePrometheusAPI(
+func providePrometheusClient(
 	promService *corev1.Service,
 	caCert *[]byte,
 	token string,
-) (v1.API, error) {
+) (api.Client, error) {
 
 	var port int32
 	if promService == nil {
@@ -122,9 +137,7 @@ func providePrometheusAPI(
 		return nil, errors.New("client configuration is nil")
 	}
 
-	promAPI := v1.NewAPI(conf)
-	// p.promAPI = promAPI
-	return promAPI, nil
+	return conf, nil
 }
 
 func providePrometheusAPIForReporter(
@@ -143,6 +156,15 @@ func providePrometheusAPIForReporter(
 		return localClient, nil
 	}
 
+	if setup.External != nil {
+		conf, err := NewSecureClientFromCert(setup.External)
+		if err != nil {
+			return nil, err
+		}
+
+		return v1.NewAPI(conf), nil
+	}
+
 	var port int32
 	name := setup.PromService.Name
 	namespace := setup.PromService.Namespace
@@ -168,6 +190,27 @@ func providePrometheusAPIForReporter(
 		auth = fmt.Sprintf(string(content))
 	}
 
+	if len(setup.Replicas) > 1 {
+		replicas := make([]v1.API, 0, len(setup.Replicas))
+
+		for _, replica := range setup.Replicas {
+			conf, err := NewSecureClient(&PrometheusSecureClientConfig{
+				Address:        fmt.Sprintf("https://%s:%v", replica, port),
+				ServerCertFile: setup.CertFilePath,
+				ServerName:     fmt.Sprintf("%s.%s.svc", name, namespace),
+				Token:          auth,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			replicas = append(replicas, v1.NewAPI(conf))
+		}
+
+		return NewReplicatedAPI(replicas...), nil
+	}
+
 	conf, err := NewSecureClient(&PrometheusSecureClientConfig{
 		Address:        fmt.Sprintf("https://%s.%s.svc:%v", name, namespace, port),
 		ServerCertFile: setup.CertFilePath,
@@ -201,77 +244,59 @@ func GetAuthTokenForKubeAdm() (token string, returnErr error) {
 }
 
 func NewSecureClient(config *PrometheusSecureClientConfig) (api.Client, error) {
-	tlsConfig, err := GenerateCACertPool(config.ServerCertFile)
-
+	caCert, err := ioutil.ReadFile(config.ServerCertFile)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get tlsConfig")
 	}
 
-	var transport http.RoundTripper
+	outbound := config.Outbound
+	outbound.CAData = append(append([][]byte{}, outbound.CAData...), caCert)
+	outbound.ServerName = config.ServerName
 
-	transport = &http.Transport{
-		TLSClientConfig: tlsConfig,
-	}
+	return newSecureClient(config, &outbound)
+}
 
-	if config.UserAuth != nil {
-		transport = WithBasicAuth(transport, config.UserAuth.Username, config.UserAuth.Password)
+func NewSecureClientFromCert(config *PrometheusSecureClientConfig) (api.Client, error) {
+	outbound := config.Outbound
+
+	if config.CaCert != nil {
+		outbound.CAData = append(append([][]byte{}, outbound.CAData...), *config.CaCert)
 	}
 
-	if config.Token != "" {
-		transport = WithBearerAuth(transport, config.Token)
+	if config.ClientCert != nil {
+		outbound.Certificates = append(append([]tls.Certificate{}, outbound.Certificates...), *config.ClientCert)
 	}
 
-	client, err := api.NewClient(api.Config{
-		Address:      config.Address,
-		RoundTripper: transport,
-	})
+	outbound.ServerName = config.ServerName
+	outbound.InsecureSkipVerify = config.InsecureSkipVerify
 
-	return client, err
+	return newSecureClient(config, &outbound)
 }
 
-func NewSecureClientFromCert(config *PrometheusSecureClientConfig) (api.Client, error) {
-
-	tlsConfig, err := generateCACertPoolFromCert(*config.CaCert)
+func newSecureClient(config *PrometheusSecureClientConfig, outbound *transport.Config) (api.Client, error) {
+	httpTransport, err := outbound.NewTransport()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get tlsConfig")
 	}
 
-	var transport http.RoundTripper
-
-	transport = &http.Transport{
-		TLSClientConfig: tlsConfig,
-	}
+	var rt http.RoundTripper = httpTransport
 
 	if config.UserAuth != nil {
-		transport = WithBasicAuth(transport, config.UserAuth.Username, config.UserAuth.Password)
+		rt = WithBasicAuth(rt, config.UserAuth.Username, config.UserAuth.Password)
 	}
 
 	if config.Token != "" {
-		transport = WithBearerAuth(transport, config.Token)
+		rt = WithBearerAuth(rt, config.Token)
 	}
 
 	client, err := api.NewClient(api.Config{
 		Address:      config.Address,
-		RoundTripper: transport,
+		RoundTripper: rt,
 	})
 
 	return client, err
 }
 
-func generateCACertPoolFromCert(caCert []byte) (*tls.Config, error) {
-	caCertPool, err := x509.SystemCertPool()
-
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get system cert pool")
-	}
-
-	caCertPool.AppendCertsFromPEM(caCert)
-
-	return &tls.Config{
-		RootCAs: caCertPool,
-	}, nil
-}
-
 func GenerateCACertPool(files ...string) (*tls.Config, error) {
 	caCertPool, err := x509.SystemCertPool()
 
@@ -23,6 +23,7 @@ import (
 
 	"emperror.dev/errors"
 	sprig "github.com/Masterminds/sprig/v3"
+	"github.com/prometheus/client_golang/api"
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	marketplacev1beta1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
@@ -47,6 +48,10 @@ type PromQueryArgs struct {
 	GroupBy       []string
 	Without       []string
 
+	// Limits of the meter definition override the limits of the
+	// PrometheusAPI.
+	Limits *v1beta1.MeterQueryLimits
+
 	defaultGroupBy []string
 }
 
@@ -78,6 +83,7 @@ func NewPromQueryFromLabels(
 		GroupBy:       []string(meterDefLabels.MetricGroupBy),
 		Without:       []string(meterDefLabels.MetricWithout),
 		AggregateFunc: meterDefLabels.MetricAggregation,
+		Limits:        queryLimitsFromLabels(meterDefLabels),
 	})
 
 }
@@ -115,11 +121,18 @@ func PromQueryFromLabels(
 		GroupBy:       []string(meterDefLabels.MetricGroupBy),
 		Without:       []string(meterDefLabels.MetricWithout),
 		AggregateFunc: meterDefLabels.MetricAggregation,
+		Limits:        queryLimitsFromLabels(meterDefLabels),
 	})
 }
 
 type PrometheusAPI struct {
 	v1.API
+
+	// Limits bound the cost of report queries
+	Limits QueryLimits
+
+	// client is used for the endpoints the v1 api does not cover
+	client api.Client
 }
 
 const TypeNotSupportedErr = errors.Sentinel("type is not supported")
@@ -234,7 +247,17 @@ func (q *PromQuery) Print() (string, error) {
 }
 
 func (p *PrometheusAPI) ReportQuery(query *PromQuery) (model.Value, v1.Warnings, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	q, err := query.Print()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p.queryRange(query, q)
+}
+
+func (p *PrometheusAPI) queryRange(query *PromQuery, q string) (model.Value, v1.Warnings, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.Limits.For(query).timeout())
 	defer cancel()
 
 	timeRange := v1.Range{
@@ -243,12 +266,6 @@ func (p *PrometheusAPI) ReportQuery(query *PromQuery) (model.Value, v1.Warnings,
 		Step:  query.Step,
 	}
 
-	q, err := query.Print()
-
-	if err != nil {
-		return nil, nil, err
-	}
-
 	logger.Info("executing query", "query", q)
 
 	result, warnings, err := p.QueryRange(ctx, q, timeRange)
@@ -292,7 +309,7 @@ type MeterDefinitionQuery struct {
 // Returns a set of elements without duplicates
 // Ignore labels such that a pod restart, meterdefinition recreate, or other labels do not generate a new unique element
 // Use max over time to get the meter definition most prevalent for the hour
-const meterDefinitionQueryStr = `max_over_time(((max without (container, endpoint, instance, job, meter_definition_uid, pod, service) (meterdef_metric_label_info{})) or on() vector(0))[{{ .Step }}:{{ .Step }}])`
+const meterDefinitionQueryStr = `max_over_time(((max without (container, endpoint, instance, job, meter_definition_uid, pod, service, prometheus, prometheus_replica) (meterdef_metric_label_info{})) or on() vector(0))[{{ .Step }}:{{ .Step }}])`
 
 var meterDefinitionQueryTemplate *template.Template = utils.Must(func() (interface{}, error) {
 	return template.New("meterDefinitionQuery").Funcs(sprig.GenericFuncMap()).Parse(meterDefinitionQueryStr)
@@ -305,7 +322,7 @@ func (q *MeterDefinitionQuery) Print() (string, error) {
 }
 
 func (p *PrometheusAPI) QueryMeterDefinitions(query *MeterDefinitionQuery) (model.Value, v1.Warnings, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), p.Limits.timeout())
 	defer cancel()
 
 	timeRange := v1.Range{
@@ -335,3 +352,69 @@ func (p *PrometheusAPI) QueryMeterDefinitions(query *MeterDefinitionQuery) (mode
 
 	return result, warnings, nil
 }
+
+// MeterDataCoverageQuery returns a sample for every step that has meter
+// definition data in the step before it.
+type MeterDataCoverageQuery struct {
+	Start, End time.Time
+	Step       time.Duration
+}
+
+const meterDataCoverageQueryStr = `count(count_over_time(meterdef_metric_label_info{}[{{ .Step }}]))`
+
+var meterDataCoverageQueryTemplate *template.Template = utils.Must(func() (interface{}, error) {
+	return template.New("meterDataCoverageQuery").Funcs(sprig.GenericFuncMap()).Parse(meterDataCoverageQueryStr)
+}).(*template.Template)
+
+func (q *MeterDataCoverageQuery) Print() (string, error) {
+	var buf strings.Builder
+	// range selectors only accept durations with a single unit, like 1h
+	err := meterDataCoverageQueryTemplate.Execute(&buf, struct {
+		Step model.Duration
+	}{
+		Step: model.Duration(q.Step),
+	})
+	return buf.String(), err
+}
+
+// QueryMeterDataCoverage returns the end of every step of the query that has
+// meter definition data.
+func (p *PrometheusAPI) QueryMeterDataCoverage(query *MeterDataCoverageQuery) (map[model.Time]bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.Limits.timeout())
+	defer cancel()
+
+	q, err := query.Print()
+
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("executing query", "query", q)
+
+	result, warnings, err := p.QueryRange(ctx, q, v1.Range{
+		Start: query.Start,
+		End:   query.End,
+		Step:  query.Step,
+	})
+
+	if err != nil {
+		logger.Error(err, "querying prometheus", "warnings", warnings)
+		return nil, toError(err)
+	}
+
+	matrix, ok := result.(model.Matrix)
+
+	if !ok {
+		return nil, errors.NewWithDetails("result type is unprocessable", "type", result.Type().String())
+	}
+
+	covered := map[model.Time]bool{}
+
+	for _, stream := range matrix {
+		for _, pair := range stream.Values {
+			covered[pair.Timestamp] = true
+		}
+	}
+
+	return covered, nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/prometheus/common/model"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const QueryLimitExceeded = errors.Sentinel("query exceeds limits")
+
+const defaultQueryTimeout = 10 * time.Second
+
+// QueryLimits bound the cost of a single meter query. A zero value disables
+// the limit; a zero timeout uses the default of 10 seconds.
+type QueryLimits struct {
+	MaxSeries  int
+	MaxSamples int
+	Timeout    time.Duration
+}
+
+// For returns the limits of the query, the limits of its meter definition
+// in place of the defaults.
+func (l QueryLimits) For(query *PromQuery) QueryLimits {
+	if query == nil || query.Limits == nil {
+		return l
+	}
+
+	if query.Limits.MaxSeries != nil {
+		l.MaxSeries = *query.Limits.MaxSeries
+	}
+
+	if query.Limits.MaxSamples != nil {
+		l.MaxSamples = *query.Limits.MaxSamples
+	}
+
+	if query.Limits.Timeout != nil {
+		l.Timeout = query.Limits.Timeout.Duration
+	}
+
+	return l
+}
+
+// queryLimitsFromLabels returns the limits of the meter definition of the
+// labels, nil if it sets none.
+func queryLimitsFromLabels(meterDefLabels *common.MeterDefPrometheusLabels) *v1beta1.MeterQueryLimits {
+	if meterDefLabels.QueryMaxSeries == nil && meterDefLabels.QueryMaxSamples == nil && meterDefLabels.QueryTimeout == nil {
+		return nil
+	}
+
+	limits := &v1beta1.MeterQueryLimits{
+		MaxSeries:  meterDefLabels.QueryMaxSeries,
+		MaxSamples: meterDefLabels.QueryMaxSamples,
+	}
+
+	if meterDefLabels.QueryTimeout != nil {
+		limits.Timeout = &metav1.Duration{Duration: meterDefLabels.QueryTimeout.Duration}
+	}
+
+	return limits
+}
+
+func (l QueryLimits) enabled() bool {
+	return l.MaxSeries > 0 || l.MaxSamples > 0
+}
+
+func (l QueryLimits) timeout() time.Duration {
+	if l.Timeout <= 0 {
+		return defaultQueryTimeout
+	}
+
+	return l.Timeout
+}
+
+// QueryCost is the estimated cost of a meter query.
+type QueryCost struct {
+	Series  int
+	Samples int
+}
+
+// EstimateQueryCost runs a dry count() of the meter query, as printed for
+// the report, at the end of the range. Samples are estimated as series times
+// the number of steps.
+func (p *PrometheusAPI) EstimateQueryCost(query *PromQuery) (*QueryCost, error) {
+	q, err := query.Print()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return p.estimateQueryCost(query, q)
+}
+
+func (p *PrometheusAPI) estimateQueryCost(query *PromQuery, q string) (*QueryCost, error) {
+	limits := p.Limits.For(query)
+	ctx, cancel := context.WithTimeout(context.Background(), limits.timeout())
+	defer cancel()
+
+	q = fmt.Sprintf("count(%s)", q)
+	logger.Info("estimating query cost", "query", q)
+
+	result, warnings, err := p.Query(ctx, q, query.End)
+
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, errors.Wrapf(QueryLimitExceeded, "cost estimate for metric %s did not finish within %s", query.Metric, limits.timeout())
+		}
+
+		logger.Error(err, "querying prometheus", "warnings", warnings)
+		return nil, toError(err)
+	}
+
+	cost := &QueryCost{}
+
+	if vector, ok := result.(model.Vector); ok && len(vector) > 0 {
+		cost.Series = int(vector[0].Value)
+	}
+
+	steps := 1
+	if query.Step > 0 {
+		steps = int(query.End.Sub(query.Start)/query.Step) + 1
+	}
+
+	cost.Samples = cost.Series * steps
+	return cost, nil
+}
+
+// CheckQueryCost returns QueryLimitExceeded if the estimated cost of the
+// query printed for the report is over the limits. Nothing is queried if no
+// limit is set.
+func (p *PrometheusAPI) CheckQueryCost(query *PromQuery) error {
+	q, err := query.Print()
+
+	if err != nil {
+		return err
+	}
+
+	return p.checkQueryCost(query, q)
+}
+
+func (p *PrometheusAPI) checkQueryCost(query *PromQuery, q string) error {
+	limits := p.Limits.For(query)
+	if !limits.enabled() {
+		return nil
+	}
+
+	cost, err := p.estimateQueryCost(query, q)
+
+	if err != nil {
+		return err
+	}
+
+	if limits.MaxSeries > 0 && cost.Series > limits.MaxSeries {
+		return errors.Wrapf(QueryLimitExceeded, "metric %s returns %d series, the limit is %d",
+			query.Metric, cost.Series, limits.MaxSeries)
+	}
+
+	if limits.MaxSamples > 0 && cost.Samples > limits.MaxSamples {
+		return errors.Wrapf(QueryLimitExceeded, "metric %s returns an estimated %d samples, the limit is %d",
+			query.Metric, cost.Samples, limits.MaxSamples)
+	}
+
+	return nil
+}
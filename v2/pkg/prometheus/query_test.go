@@ -15,14 +15,20 @@
 package prometheus
 
 import (
+	"fmt"
+	"net/http"
 	"time"
 
+	"emperror.dev/errors"
 	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/common"
 	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
 	. "github.com/redhat-marketplace/redhat-marketplace-operator/v2/tests/mock/mock_query"
 )
@@ -50,10 +56,103 @@ var _ = Describe("Query", func() {
 
 		v1api = GetTestAPI(MockResponseRoundTripper("../../../reporter/v2/test/mockresponses/prometheus-query-range.json", []v1beta1.MeterDefinition{}))
 		prometheusAPI = PrometheusAPI{
-			v1api,
+			API: v1api,
 		}
 	})
 
+	Context("query limits", func() {
+		BeforeEach(func() {
+			prometheusAPI = PrometheusAPI{
+				API: GetTestAPI(MockCountResponseRoundTripper("100")),
+			}
+		})
+
+		It("should estimate the cost of a query", func() {
+			var estimated string
+			countResponse := MockCountResponseRoundTripper("100")
+			prometheusAPI.API = GetTestAPI(func(req *http.Request) *http.Response {
+				Expect(req.ParseForm()).To(Succeed())
+				estimated = req.Form.Get("query")
+				return countResponse(req)
+			})
+
+			cost, err := prometheusAPI.EstimateQueryCost(testQuery)
+
+			Expect(err).To(Succeed())
+			Expect(cost.Series).To(Equal(100))
+			Expect(cost.Samples).To(Equal(400))
+
+			q, err := testQuery.Print()
+			Expect(err).To(Succeed())
+			Expect(estimated).To(Equal(fmt.Sprintf("count(%s)", q)))
+			Expect(estimated).To(ContainSubstring("meterdef_pod_info"))
+		})
+
+		It("should estimate the cost of the recorded query", func() {
+			var estimated string
+			countResponse := MockCountResponseRoundTripper("100")
+			prometheusAPI.API = GetTestAPI(func(req *http.Request) *http.Response {
+				Expect(req.ParseForm()).To(Succeed())
+				estimated = req.Form.Get("query")
+				return countResponse(req)
+			})
+			prometheusAPI.Limits = QueryLimits{MaxSeries: 10}
+
+			err := prometheusAPI.CheckRecordedQueryCost(testQuery)
+			Expect(errors.Is(err, QueryLimitExceeded)).To(BeTrue())
+			Expect(estimated).To(Equal(fmt.Sprintf("count(%s)", testQuery.PrintRecorded())))
+		})
+
+		It("should allow queries under the limits", func() {
+			prometheusAPI.Limits = QueryLimits{MaxSeries: 100, MaxSamples: 400}
+
+			Expect(prometheusAPI.CheckQueryCost(testQuery)).To(Succeed())
+		})
+
+		It("should reject queries with too many series", func() {
+			prometheusAPI.Limits = QueryLimits{MaxSeries: 99}
+
+			err := prometheusAPI.CheckQueryCost(testQuery)
+			Expect(errors.Is(err, QueryLimitExceeded)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("100 series"))
+		})
+
+		It("should reject queries with too many samples", func() {
+			prometheusAPI.Limits = QueryLimits{MaxSamples: 399}
+
+			err := prometheusAPI.CheckQueryCost(testQuery)
+			Expect(errors.Is(err, QueryLimitExceeded)).To(BeTrue())
+		})
+
+		It("should use the limits of the meter definition", func() {
+			prometheusAPI.Limits = QueryLimits{MaxSeries: 99}
+
+			maxSeries, maxSamples := 100, 0
+			meterdef := &v1beta1.MeterDefinition{
+				Spec: v1beta1.MeterDefinitionSpec{
+					Meters: []v1beta1.MeterWorkload{{Metric: "rpc_durations_seconds_count", Query: `foo{bar="true"}`, WorkloadType: v1beta1.WorkloadTypePod}},
+					QueryLimits: &v1beta1.MeterQueryLimits{
+						MaxSeries:  &maxSeries,
+						MaxSamples: &maxSamples,
+						Timeout:    &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			}
+
+			labelMap, err := meterdef.ToPrometheusLabels()[0].ToLabels()
+			Expect(err).To(Succeed())
+			Expect(labelMap).To(HaveKeyWithValue("query_max_series", "100"))
+
+			meterDefLabels := &common.MeterDefPrometheusLabels{}
+			Expect(meterDefLabels.FromLabels(labelMap)).To(Succeed())
+
+			query := PromQueryFromLabels(meterDefLabels, testQuery.Start, testQuery.End)
+			Expect(prometheusAPI.Limits.For(query)).To(Equal(QueryLimits{MaxSeries: 100, Timeout: time.Minute}))
+			Expect(prometheusAPI.CheckQueryCost(query)).To(Succeed())
+			Expect(prometheusAPI.CheckQueryCost(testQuery)).ToNot(Succeed())
+		})
+	})
+
 	It("should query a range", func() {
 		result, warnings, err := prometheusAPI.ReportQuery(testQuery)
 
@@ -104,4 +203,19 @@ var _ = Describe("Query", func() {
 		Expect(err).To(Succeed())
 		Expect(q).To(Equal(expected), "failed to create query for pvc")
 	})
+
+	It("should build a meter data coverage query", func() {
+		q1 := &MeterDataCoverageQuery{
+			Start: time.Now().Add(-24 * time.Hour),
+			End:   time.Now(),
+			Step:  time.Hour,
+		}
+
+		q, err := q1.Print()
+		Expect(err).To(Succeed())
+		Expect(q).To(Equal(`count(count_over_time(meterdef_metric_label_info{}[1h]))`))
+
+		_, err = parser.ParseExpr(q)
+		Expect(err).To(Succeed())
+	})
 })
@@ -0,0 +1,136 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// RecordedMeterName is the series every meter query is recorded to. Meters
+// are told apart by the RecordedMeterLabels.
+const RecordedMeterName = "meterdef:meter_value"
+
+// RecordedMeterLabels are added to the recorded series of a meter.
+var RecordedMeterLabels = []string{"meter_def_name", "meter_def_namespace", "meter_def_metric"}
+
+func (q *PromQuery) recordedLabels() map[string]string {
+	return map[string]string{
+		"meter_def_name":      q.MeterDef.Name,
+		"meter_def_namespace": q.MeterDef.Namespace,
+		"meter_def_metric":    q.Metric,
+	}
+}
+
+// RecordingInterval is how often the meter query is recorded, once per step
+// of the meter, so each recording costs one evaluation of the query per
+// period.
+func (q *PromQuery) RecordingInterval() time.Duration {
+	if q.Step <= 0 {
+		return time.Hour
+	}
+
+	return q.Step
+}
+
+// RecordingRuleGroup returns the rule group that records the meter query at
+// the RecordingInterval.
+func (q *PromQuery) RecordingRuleGroup() (monitoringv1.RuleGroup, error) {
+	expr, err := q.Print()
+
+	if err != nil {
+		return monitoringv1.RuleGroup{}, err
+	}
+
+	return monitoringv1.RuleGroup{
+		Name:     fmt.Sprintf("%s/%s/%s", q.MeterDef.Namespace, q.MeterDef.Name, q.Metric),
+		Interval: model.Duration(q.RecordingInterval()).String(),
+		Rules: []monitoringv1.Rule{
+			{
+				Record: RecordedMeterName,
+				Expr:   intstr.FromString(expr),
+				Labels: q.recordedLabels(),
+			},
+		},
+	}, nil
+}
+
+// PrintRecorded returns the query for the recorded series of the meter. The
+// recording labels are dropped so the result has the same labels as the
+// query returned by Print. The external labels of remote written series are
+// dropped too, so the series of each Prometheus replica are deduplicated.
+//
+// The recorded series has one sample per step, further apart than the
+// lookback delta, so each step reads the sample recorded within it. The
+// window excludes its start so a sample recorded at the previous step is
+// not read twice. Prometheus offsets the evaluations of a rule group within
+// its interval, so a step reads the meter as of its recording rather than
+// as of the end of the step.
+func (q *PromQuery) PrintRecorded() string {
+	labels := q.recordedLabels()
+	filters := make([]string, 0, len(labels))
+
+	for key, value := range labels {
+		filters = append(filters, makeLabel(key, value))
+	}
+
+	sort.Strings(filters)
+
+	return fmt.Sprintf("max without (%s) (max_over_time(%s{%s}[%s]))",
+		strings.Join(append(append([]string{}, RecordedMeterLabels...), ReplicaExternalLabels...), ","),
+		RecordedMeterName,
+		strings.Join(filters, ","),
+		model.Duration(q.RecordingInterval()-time.Millisecond))
+}
+
+// RecordedReportQuery runs the report query against the recorded series of
+// the meter.
+func (p *PrometheusAPI) RecordedReportQuery(query *PromQuery) (model.Value, v1.Warnings, error) {
+	return p.queryRange(query, query.PrintRecorded())
+}
+
+// CheckRecordedQueryCost returns QueryLimitExceeded if the estimated cost of
+// the query of the recorded series of the meter is over the limits.
+func (p *PrometheusAPI) CheckRecordedQueryCost(query *PromQuery) error {
+	return p.checkQueryCost(query, query.PrintRecorded())
+}
+
+// IsRecordedFor returns true if the recorded result covers the start of the
+// query. Meters recorded after the report started are queried from the raw
+// series instead.
+func IsRecordedFor(query *PromQuery, value model.Value) bool {
+	matrix, ok := value.(model.Matrix)
+
+	if !ok || len(matrix) == 0 {
+		return false
+	}
+
+	first := model.TimeFromUnixNano(query.Start.Add(query.Step).UnixNano())
+
+	for _, stream := range matrix {
+		if len(stream.Values) != 0 && !stream.Values[0].Timestamp.After(first) {
+			return true
+		}
+	}
+
+	return false
+}
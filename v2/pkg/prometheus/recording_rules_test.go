@@ -0,0 +1,204 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"sort"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/rules"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// recordingRuleFixture is loaded into a local Prometheus engine, starting at
+// the unix epoch with a sample every minute for 3 hours. The cpu rate of p1
+// falls and the rate of p2 rises every hour, so each step of a report reads
+// a different value.
+const recordingRuleFixture = `
+load 1m
+	meterdef_pod_info{meter_def_name="foo",meter_def_namespace="foons",pod="p1",namespace="ns1",instance="a"} 1x180
+	meterdef_pod_info{meter_def_name="foo",meter_def_namespace="foons",pod="p2",namespace="ns1",instance="a"} 1x180
+	meterdef_pod_info{meter_def_name="bar",meter_def_namespace="foons",pod="p3",namespace="ns1",instance="a"} 1x180
+	container_cpu_usage_seconds_total{pod="p1",namespace="ns1",container="c1",instance="a"} 0+240x60 14520+120x59 21660+60x59
+	container_cpu_usage_seconds_total{pod="p1",namespace="ns1",container="c2",instance="a"} 0+30x180
+	container_cpu_usage_seconds_total{pod="p2",namespace="ns1",container="c1",instance="a"} 0+120x60 7440+240x59 21960+360x59
+	container_cpu_usage_seconds_total{pod="p3",namespace="ns1",container="c1",instance="a"} 0+240x180
+`
+
+var _ = Describe("RecordingRules", func() {
+	var (
+		test       *promql.Test
+		query      *PromQuery
+		start, end time.Time
+	)
+
+	rangeQuery := func(q string) promql.Matrix {
+		rq, err := test.QueryEngine().NewRangeQuery(test.Queryable(), q, start, end, query.Step)
+		Expect(err).To(Succeed())
+		defer rq.Close()
+
+		result := rq.Exec(test.Context())
+		Expect(result.Err).To(Succeed())
+
+		pooled, err := result.Matrix()
+		Expect(err).To(Succeed())
+
+		// the series and their points are pooled and reused by the next query
+		// once this one is closed
+		matrix := make(promql.Matrix, 0, len(pooled))
+		for _, series := range pooled {
+			matrix = append(matrix, promql.Series{
+				Metric: series.Metric.Copy(),
+				Points: append([]promql.Point(nil), series.Points...),
+			})
+		}
+
+		// aggregations return series in hash order
+		sort.Sort(matrix)
+		return matrix
+	}
+
+	record := func(from, to time.Time) {
+		group, err := query.RecordingRuleGroup()
+		Expect(err).To(Succeed())
+		Expect(group.Rules).To(HaveLen(1))
+
+		expr, err := parser.ParseExpr(group.Rules[0].Expr.String())
+		Expect(err).To(Succeed())
+
+		rule := rules.NewRecordingRule(group.Rules[0].Record, expr, labels.FromMap(group.Rules[0].Labels))
+		queryFunc := rules.EngineQueryFunc(test.QueryEngine(), test.Storage())
+
+		for ts := from; !ts.After(to); ts = ts.Add(query.RecordingInterval()) {
+			vector, err := rule.Eval(test.Context(), ts, queryFunc, nil)
+			Expect(err).To(Succeed())
+
+			app := test.Storage().Appender(test.Context())
+			for _, sample := range vector {
+				_, err := app.Add(sample.Metric, sample.T, sample.V)
+				Expect(err).To(Succeed())
+			}
+			Expect(app.Commit()).To(Succeed())
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		test, err = promql.NewTest(GinkgoT(), recordingRuleFixture)
+		Expect(err).To(Succeed())
+		Expect(test.Run()).To(Succeed())
+
+		start = time.Unix(0, 0).UTC().Add(time.Hour)
+		end = start.Add(2 * time.Hour)
+
+		query = NewPromQuery(&PromQueryArgs{
+			Metric:        "cpu_usage",
+			Query:         "sum by (pod,namespace) (rate(container_cpu_usage_seconds_total[5m]))",
+			Type:          v1beta1.WorkloadTypePod,
+			MeterDef:      types.NamespacedName{Name: "foo", Namespace: "foons"},
+			AggregateFunc: "sum",
+			Start:         start,
+			End:           end,
+			Step:          time.Hour,
+		})
+	})
+
+	AfterEach(func() {
+		test.Close()
+	})
+
+	It("should create a recording rule group for the meter", func() {
+		group, err := query.RecordingRuleGroup()
+		Expect(err).To(Succeed())
+
+		expr, err := query.Print()
+		Expect(err).To(Succeed())
+
+		Expect(group.Name).To(Equal("foons/foo/cpu_usage"))
+		Expect(group.Interval).To(Equal("1h"))
+		Expect(group.Rules[0].Record).To(Equal(RecordedMeterName))
+		Expect(group.Rules[0].Expr.String()).To(Equal(expr))
+		Expect(group.Rules[0].Labels).To(Equal(map[string]string{
+			"meter_def_name":      "foo",
+			"meter_def_namespace": "foons",
+			"meter_def_metric":    "cpu_usage",
+		}))
+	})
+
+	It("should return the same result from the recorded series", func() {
+		record(time.Unix(0, 0).UTC(), end)
+
+		raw, err := query.Print()
+		Expect(err).To(Succeed())
+
+		expected := rangeQuery(raw)
+		actual := rangeQuery(query.PrintRecorded())
+
+		// p1 and p2 are metered by foo, p3 belongs to bar
+		Expect(expected).To(HaveLen(2))
+		Expect(actual).To(HaveLen(len(expected)))
+
+		for i := range expected {
+			Expect(actual[i].Metric).To(Equal(expected[i].Metric))
+			Expect(expected[i].Points).To(HaveLen(3))
+			Expect(expected[i].Points[0].V).ToNot(BeNumerically("~", expected[i].Points[1].V, 1e-9))
+			Expect(expected[i].Points[1].V).ToNot(BeNumerically("~", expected[i].Points[2].V, 1e-9))
+			Expect(actual[i].Points).To(HaveLen(len(expected[i].Points)))
+
+			for j, point := range expected[i].Points {
+				Expect(actual[i].Points[j].T).To(Equal(point.T))
+				Expect(actual[i].Points[j].V).To(BeNumerically("~", point.V, 1e-9))
+			}
+		}
+
+		Expect(IsRecordedFor(query, toModelMatrix(actual))).To(BeTrue())
+	})
+
+	It("should only use recorded series that cover the report", func() {
+		record(start.Add(90*time.Minute), end)
+
+		Expect(IsRecordedFor(query, toModelMatrix(rangeQuery(query.PrintRecorded())))).To(BeFalse())
+	})
+})
+
+func toModelMatrix(matrix promql.Matrix) model.Matrix {
+	result := model.Matrix{}
+
+	for _, series := range matrix {
+		stream := &model.SampleStream{Metric: model.Metric{}}
+
+		for _, l := range series.Metric {
+			stream.Metric[model.LabelName(l.Name)] = model.LabelValue(l.Value)
+		}
+
+		for _, p := range series.Points {
+			stream.Values = append(stream.Values, model.SamplePair{
+				Timestamp: model.Time(p.T),
+				Value:     model.SampleValue(p.V),
+			})
+		}
+
+		result = append(result, stream)
+	}
+
+	return result
+}
@@ -0,0 +1,190 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+const (
+	// PrometheusExternalLabelName is the external label naming the Prometheus
+	// the series are sent from.
+	PrometheusExternalLabelName = "prometheus"
+
+	// ReplicaExternalLabelName is the external label naming the Prometheus pod
+	// the series are sent from.
+	ReplicaExternalLabelName = "prometheus_replica"
+)
+
+// ReplicaExternalLabels are dropped from query results so the series of each
+// Prometheus replica are deduplicated.
+var ReplicaExternalLabels = []string{PrometheusExternalLabelName, ReplicaExternalLabelName}
+
+// replicatedAPI queries every replica of a highly available Prometheus. The
+// replicas scrape the same targets, so range and instant queries are merged
+// to fill the gaps of a replica that was down. Other calls, like the series
+// and label lookups, go to the first replica; they only read metadata and
+// are not used for the report data.
+type replicatedAPI struct {
+	v1.API
+	replicas []v1.API
+}
+
+// NewReplicatedAPI returns an api merging the queries of the replicas.
+func NewReplicatedAPI(replicas ...v1.API) v1.API {
+	if len(replicas) == 1 {
+		return replicas[0]
+	}
+
+	return &replicatedAPI{API: replicas[0], replicas: replicas}
+}
+
+// QueryRange queries every replica and merges their results. It only fails
+// if all replicas fail.
+func (r *replicatedAPI) QueryRange(ctx context.Context, query string, rng v1.Range) (model.Value, v1.Warnings, error) {
+	var (
+		matrices []model.Matrix
+		warnings v1.Warnings
+		errs     error
+	)
+
+	for i, replica := range r.replicas {
+		value, warns, err := replica.QueryRange(ctx, query, rng)
+		warnings = append(warnings, warns...)
+
+		if err != nil {
+			errs = errors.Append(errs, errors.WrapWithDetails(err, "replica query failed", "replica", i))
+			continue
+		}
+
+		matrix, ok := value.(model.Matrix)
+		if !ok {
+			return value, warnings, nil
+		}
+
+		matrices = append(matrices, matrix)
+	}
+
+	if len(matrices) == 0 {
+		return nil, warnings, errs
+	}
+
+	return MergeMatrices(matrices...), warnings, nil
+}
+
+// Query queries every replica and merges their vectors. It only fails if all
+// replicas fail.
+func (r *replicatedAPI) Query(ctx context.Context, query string, ts time.Time) (model.Value, v1.Warnings, error) {
+	var (
+		vectors  []model.Vector
+		warnings v1.Warnings
+		errs     error
+	)
+
+	for i, replica := range r.replicas {
+		value, warns, err := replica.Query(ctx, query, ts)
+		warnings = append(warnings, warns...)
+
+		if err != nil {
+			errs = errors.Append(errs, errors.WrapWithDetails(err, "replica query failed", "replica", i))
+			continue
+		}
+
+		vector, ok := value.(model.Vector)
+		if !ok {
+			return value, warnings, nil
+		}
+
+		vectors = append(vectors, vector)
+	}
+
+	if len(vectors) == 0 {
+		return nil, warnings, errs
+	}
+
+	return MergeVectors(vectors...), warnings, nil
+}
+
+// MergeVectors joins the samples of the vectors by their labels. Samples of
+// the first vector win on duplicate labels.
+func MergeVectors(vectors ...model.Vector) model.Vector {
+	seen := map[model.Fingerprint]bool{}
+	result := model.Vector{}
+
+	for _, vector := range vectors {
+		for _, sample := range vector {
+			fingerprint := sample.Metric.Fingerprint()
+
+			if seen[fingerprint] {
+				continue
+			}
+
+			seen[fingerprint] = true
+			result = append(result, sample)
+		}
+	}
+
+	return result
+}
+
+// MergeMatrices joins the streams of the matrices by their labels. Samples
+// of the first matrix win on duplicate timestamps.
+func MergeMatrices(matrices ...model.Matrix) model.Matrix {
+	streams := map[model.Fingerprint]*model.SampleStream{}
+	result := model.Matrix{}
+
+	for _, matrix := range matrices {
+		for _, stream := range matrix {
+			fingerprint := stream.Metric.Fingerprint()
+			merged, ok := streams[fingerprint]
+
+			if !ok {
+				merged = &model.SampleStream{Metric: stream.Metric}
+				streams[fingerprint] = merged
+				result = append(result, merged)
+			}
+
+			for _, pair := range stream.Values {
+				if !hasTimestamp(merged.Values, pair.Timestamp) {
+					merged.Values = append(merged.Values, pair)
+				}
+			}
+		}
+	}
+
+	for _, stream := range result {
+		sort.Slice(stream.Values, func(i, j int) bool {
+			return stream.Values[i].Timestamp.Before(stream.Values[j].Timestamp)
+		})
+	}
+
+	return result
+}
+
+func hasTimestamp(values []model.SamplePair, timestamp model.Time) bool {
+	for _, pair := range values {
+		if pair.Timestamp.Equal(timestamp) {
+			return true
+		}
+	}
+
+	return false
+}
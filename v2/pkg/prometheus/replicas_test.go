@@ -0,0 +1,161 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/api"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+var _ = Describe("Replicas", func() {
+	var (
+		servers []*httptest.Server
+		start   = time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+		rng     = v1.Range{Start: start, End: start.Add(2 * time.Hour), Step: time.Hour}
+	)
+
+	// serves a matrix with a sample at each of the timestamps
+	newReplica := func(status int, timestamps ...time.Time) v1.API {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				return
+			}
+
+			values := ""
+			for i, t := range timestamps {
+				if i > 0 {
+					values += ","
+				}
+				values += fmt.Sprintf(`[%d,"1"]`, t.Unix())
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(fmt.Sprintf(`{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"pod":"example-app-pod"},"values":[%s]}]}}`, values)))
+		}))
+		servers = append(servers, server)
+
+		client, err := api.NewClient(api.Config{Address: server.URL})
+		Expect(err).To(Succeed())
+
+		return v1.NewAPI(client)
+	}
+
+	// serves a vector with a sample for each of the pods
+	newInstantReplica := func(status int, pods ...string) v1.API {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				return
+			}
+
+			result := ""
+			for i, pod := range pods {
+				if i > 0 {
+					result += ","
+				}
+				result += fmt.Sprintf(`{"metric":{"pod":"%s"},"value":[%d,"1"]}`, pod, start.Unix())
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(fmt.Sprintf(`{"status":"success","data":{"resultType":"vector","result":[%s]}}`, result)))
+		}))
+		servers = append(servers, server)
+
+		client, err := api.NewClient(api.Config{Address: server.URL})
+		Expect(err).To(Succeed())
+
+		return v1.NewAPI(client)
+	}
+
+	AfterEach(func() {
+		for _, server := range servers {
+			server.Close()
+		}
+		servers = nil
+	})
+
+	It("should fill the gaps of a replica", func() {
+		sut := NewReplicatedAPI(
+			newReplica(http.StatusOK, start, start.Add(2*time.Hour)),
+			newReplica(http.StatusOK, start, start.Add(time.Hour)),
+		)
+
+		val, _, err := sut.QueryRange(context.TODO(), "my_query", rng)
+		Expect(err).To(Succeed())
+
+		matrix := val.(model.Matrix)
+		Expect(matrix).To(HaveLen(1))
+		Expect(matrix[0].Values).To(HaveLen(3))
+		Expect(matrix[0].Values[1].Timestamp.Time().UTC()).To(Equal(start.Add(time.Hour)))
+	})
+
+	It("should tolerate a failed replica", func() {
+		sut := NewReplicatedAPI(
+			newReplica(http.StatusServiceUnavailable),
+			newReplica(http.StatusOK, start),
+		)
+
+		val, _, err := sut.QueryRange(context.TODO(), "my_query", rng)
+		Expect(err).To(Succeed())
+		Expect(val.(model.Matrix)).To(HaveLen(1))
+	})
+
+	It("should fail if every replica fails", func() {
+		sut := NewReplicatedAPI(
+			newReplica(http.StatusServiceUnavailable),
+			newReplica(http.StatusServiceUnavailable),
+		)
+
+		_, _, err := sut.QueryRange(context.TODO(), "my_query", rng)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should merge the instant queries of the replicas", func() {
+		sut := NewReplicatedAPI(
+			newInstantReplica(http.StatusOK, "pod-a", "pod-b"),
+			newInstantReplica(http.StatusServiceUnavailable),
+			newInstantReplica(http.StatusOK, "pod-b", "pod-c"),
+		)
+
+		val, _, err := sut.Query(context.TODO(), "my_query", start)
+		Expect(err).To(Succeed())
+
+		pods := []string{}
+		for _, sample := range val.(model.Vector) {
+			pods = append(pods, string(sample.Metric["pod"]))
+		}
+		Expect(pods).To(ConsistOf("pod-a", "pod-b", "pod-c"))
+	})
+
+	It("should fail the instant query if every replica fails", func() {
+		sut := NewReplicatedAPI(
+			newInstantReplica(http.StatusServiceUnavailable),
+			newInstantReplica(http.StatusServiceUnavailable),
+		)
+
+		_, _, err := sut.Query(context.TODO(), "my_query", start)
+		Expect(err).To(HaveOccurred())
+	})
+})
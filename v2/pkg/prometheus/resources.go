@@ -0,0 +1,230 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"emperror.dev/errors"
+	sprig "github.com/Masterminds/sprig/v3"
+	"github.com/prometheus/common/model"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// ContainerCPUUsageMetric and ContainerMemoryUsageMetric are the kubelet
+	// series the resource recommendations are computed from.
+	ContainerCPUUsageMetric    = "container_cpu_usage_seconds_total"
+	ContainerMemoryUsageMetric = "container_memory_working_set_bytes"
+
+	// RequestQuantile and LimitQuantile are the usage quantiles the requests
+	// and limits are recommended from.
+	RequestQuantile = 0.9
+	LimitQuantile   = 0.99
+
+	// requestHeadroom and limitHeadroom are added to the observed usage so
+	// a container is not throttled or killed on the next peak.
+	requestHeadroom = 1.2
+	limitHeadroom   = 1.5
+)
+
+var (
+	minRecommendedCPU    = resource.MustParse("10m")
+	minRecommendedMemory = resource.MustParse("20Mi")
+)
+
+// ContainerUsage is the cpu in cores and the memory in bytes used by a
+// container.
+type ContainerUsage struct {
+	CPU    float64
+	Memory float64
+}
+
+// ContainerUsageQuery reads a quantile of the usage of the containers of the
+// pods matching PodRegex over the window. The usage of the pods of a
+// container name is reduced to the highest.
+type ContainerUsageQuery struct {
+	Namespace string
+	PodRegex  string
+	Quantile  float64
+	Window    model.Duration
+	Step      model.Duration
+}
+
+const containerCPUUsageQueryStr = `max by (container) (quantile_over_time({{ .Quantile }}, sum by (pod, container) (rate(container_cpu_usage_seconds_total{namespace="{{ .Namespace }}",pod=~"{{ .PodRegex }}",container!="",container!="POD"}[5m]))[{{ .Window }}:{{ .Step }}]))`
+
+const containerMemoryUsageQueryStr = `max by (container) (quantile_over_time({{ .Quantile }}, sum by (pod, container) (container_memory_working_set_bytes{namespace="{{ .Namespace }}",pod=~"{{ .PodRegex }}",container!="",container!="POD"})[{{ .Window }}:{{ .Step }}]))`
+
+var containerCPUUsageQueryTemplate *template.Template = utils.Must(func() (interface{}, error) {
+	return template.New("containerCPUUsageQuery").Funcs(sprig.GenericFuncMap()).Parse(containerCPUUsageQueryStr)
+}).(*template.Template)
+
+var containerMemoryUsageQueryTemplate *template.Template = utils.Must(func() (interface{}, error) {
+	return template.New("containerMemoryUsageQuery").Funcs(sprig.GenericFuncMap()).Parse(containerMemoryUsageQueryStr)
+}).(*template.Template)
+
+// Print returns the cpu and the memory queries.
+func (q *ContainerUsageQuery) Print() (string, string, error) {
+	var cpu, memory strings.Builder
+
+	if err := containerCPUUsageQueryTemplate.Execute(&cpu, q); err != nil {
+		return "", "", err
+	}
+
+	if err := containerMemoryUsageQueryTemplate.Execute(&memory, q); err != nil {
+		return "", "", err
+	}
+
+	return cpu.String(), memory.String(), nil
+}
+
+// QueryContainerUsage returns the usage by container name. Containers
+// without samples in the window are left out.
+func (p *PrometheusAPI) QueryContainerUsage(ctx context.Context, query *ContainerUsageQuery) (map[string]ContainerUsage, error) {
+	cpuQuery, memoryQuery, err := query.Print()
+
+	if err != nil {
+		return nil, err
+	}
+
+	usage := map[string]ContainerUsage{}
+
+	for _, q := range []struct {
+		query string
+		set   func(*ContainerUsage, float64)
+	}{
+		{query: cpuQuery, set: func(u *ContainerUsage, v float64) { u.CPU = v }},
+		{query: memoryQuery, set: func(u *ContainerUsage, v float64) { u.Memory = v }},
+	} {
+		vector, err := p.queryVector(ctx, q.query)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sample := range vector {
+			container := string(sample.Metric["container"])
+			value := float64(sample.Value)
+
+			if container == "" || math.IsNaN(value) {
+				continue
+			}
+
+			u := usage[container]
+			q.set(&u, value)
+			usage[container] = u
+		}
+	}
+
+	return usage, nil
+}
+
+func (p *PrometheusAPI) queryVector(ctx context.Context, q string) (model.Vector, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.Limits.timeout())
+	defer cancel()
+
+	logger.Info("executing query", "query", q)
+
+	result, warnings, err := p.Query(ctx, q, time.Now())
+
+	if err != nil {
+		logger.Error(err, "querying prometheus", "warnings", warnings)
+		return nil, toError(err)
+	}
+
+	vector, ok := result.(model.Vector)
+
+	if !ok {
+		return nil, errors.NewWithDetails("result type is unprocessable", "type", result.Type().String())
+	}
+
+	return vector, nil
+}
+
+// RecommendResources returns the recommendations of the containers of the
+// component, sorted by container. Requests are recommended from the request
+// usage and limits from the limit usage, both with headroom.
+func RecommendResources(
+	component string,
+	requestUsage, limitUsage map[string]ContainerUsage,
+) []v1alpha1.ContainerResourceRecommendation {
+	recommendations := []v1alpha1.ContainerResourceRecommendation{}
+
+	for container, request := range requestUsage {
+		limit, ok := limitUsage[container]
+		if !ok {
+			limit = request
+		}
+
+		requests := corev1.ResourceList{
+			corev1.ResourceCPU:    recommendedCPU(request.CPU * requestHeadroom),
+			corev1.ResourceMemory: recommendedMemory(request.Memory * requestHeadroom),
+		}
+
+		limits := corev1.ResourceList{
+			corev1.ResourceCPU:    maxQuantity(recommendedCPU(limit.CPU*limitHeadroom), requests[corev1.ResourceCPU]),
+			corev1.ResourceMemory: maxQuantity(recommendedMemory(limit.Memory*limitHeadroom), requests[corev1.ResourceMemory]),
+		}
+
+		recommendations = append(recommendations, v1alpha1.ContainerResourceRecommendation{
+			Component: component,
+			Container: container,
+			Resources: corev1.ResourceRequirements{
+				Requests: requests,
+				Limits:   limits,
+			},
+		})
+	}
+
+	sort.Slice(recommendations, func(i, j int) bool {
+		return recommendations[i].Container < recommendations[j].Container
+	})
+
+	return recommendations
+}
+
+// recommendedCPU rounds the cores up to the next millicore.
+func recommendedCPU(cores float64) resource.Quantity {
+	cpu := *resource.NewMilliQuantity(int64(roundUp(cores*1000)), resource.DecimalSI)
+	return maxQuantity(cpu, minRecommendedCPU)
+}
+
+// recommendedMemory rounds the bytes up to the next Mi.
+func recommendedMemory(bytes float64) resource.Quantity {
+	mi := float64(1 << 20)
+	memory := *resource.NewQuantity(int64(roundUp(bytes/mi))<<20, resource.BinarySI)
+	return maxQuantity(memory, minRecommendedMemory)
+}
+
+// roundUp rounds up ignoring the error of the headroom multiplication.
+func roundUp(v float64) float64 {
+	return math.Ceil(v - 1e-9)
+}
+
+func maxQuantity(a, b resource.Quantity) resource.Quantity {
+	if a.Cmp(b) < 0 {
+		return b.DeepCopy()
+	}
+
+	return a.DeepCopy()
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/api"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("Resources", func() {
+	query := &ContainerUsageQuery{
+		Namespace: "openshift-redhat-marketplace",
+		PodRegex:  "rhm-metric-state-.*",
+		Quantile:  RequestQuantile,
+		Window:    model.Duration(7 * 24 * time.Hour),
+		Step:      model.Duration(5 * time.Minute),
+	}
+
+	It("should print the usage queries", func() {
+		cpu, memory, err := query.Print()
+
+		Expect(err).To(Succeed())
+		Expect(cpu).To(Equal(`max by (container) (quantile_over_time(0.9, sum by (pod, container) (rate(container_cpu_usage_seconds_total{namespace="openshift-redhat-marketplace",pod=~"rhm-metric-state-.*",container!="",container!="POD"}[5m]))[1w:5m]))`))
+		Expect(memory).To(Equal(`max by (container) (quantile_over_time(0.9, sum by (pod, container) (container_memory_working_set_bytes{namespace="openshift-redhat-marketplace",pod=~"rhm-metric-state-.*",container!="",container!="POD"})[1w:5m]))`))
+	})
+
+	It("should read the usage by container", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			Expect(req.URL.Path).To(Equal("/api/v1/query"))
+			Expect(req.ParseForm()).To(Succeed())
+
+			value := "0.25"
+			if strings.Contains(req.Form.Get("query"), ContainerMemoryUsageMetric) {
+				value = "104857600"
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{"container":"metric-state"},"value":[1598896800,"` + value + `"]}]}}`))
+		}))
+		defer server.Close()
+
+		client, err := api.NewClient(api.Config{Address: server.URL})
+		Expect(err).To(Succeed())
+
+		sut := &PrometheusAPI{API: v1.NewAPI(client), client: client}
+		usage, err := sut.QueryContainerUsage(context.TODO(), query)
+
+		Expect(err).To(Succeed())
+		Expect(usage).To(Equal(map[string]ContainerUsage{
+			"metric-state": {CPU: 0.25, Memory: 104857600},
+		}))
+	})
+
+	It("should recommend requests and limits with headroom", func() {
+		recommendations := RecommendResources("metric-state",
+			map[string]ContainerUsage{
+				"metric-state":      {CPU: 0.1, Memory: 100 << 20},
+				"kube-rbac-proxy-1": {CPU: 0.0001, Memory: 1 << 20},
+			},
+			map[string]ContainerUsage{
+				"metric-state": {CPU: 0.2, Memory: 200 << 20},
+			},
+		)
+
+		Expect(recommendations).To(HaveLen(2))
+
+		proxy := recommendations[0]
+		Expect(proxy.Container).To(Equal("kube-rbac-proxy-1"))
+		Expect(proxy.Component).To(Equal("metric-state"))
+		Expect(proxy.Resources.Requests.Cpu().String()).To(Equal("10m"))
+		Expect(proxy.Resources.Requests.Memory().String()).To(Equal("20Mi"))
+		Expect(proxy.Resources.Limits.Cpu().String()).To(Equal("10m"))
+		Expect(proxy.Resources.Limits.Memory().String()).To(Equal("20Mi"))
+
+		metricState := recommendations[1]
+		Expect(metricState.Container).To(Equal("metric-state"))
+		Expect(metricState.Resources.Requests.Cpu().String()).To(Equal("120m"))
+		Expect(metricState.Resources.Requests.Memory().String()).To(Equal("120Mi"))
+		Expect(metricState.Resources.Limits.Cpu().String()).To(Equal("300m"))
+		Expect(metricState.Resources.Limits.Memory().String()).To(Equal("300Mi"))
+	})
+
+	It("should not limit below the request", func() {
+		recommendations := RecommendResources("reporter",
+			map[string]ContainerUsage{"reporter": {CPU: 1, Memory: 1 << 30}},
+			map[string]ContainerUsage{"reporter": {CPU: 0.5, Memory: 512 << 20}},
+		)
+
+		resources := recommendations[0].Resources
+		Expect(resources.Limits[corev1.ResourceCPU]).To(Equal(resources.Requests[corev1.ResourceCPU]))
+		Expect(resources.Limits.Memory().Cmp(resource.MustParse("1229Mi"))).To(Equal(0))
+	})
+})
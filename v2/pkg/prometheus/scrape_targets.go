@@ -0,0 +1,280 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// UndeterminedMetricNames is returned for selectors that don't select
+// metrics by their name, like {__name__=~"..."} or {job="..."}.
+const UndeterminedMetricNames = errors.Sentinel("metric names can't be determined")
+
+// MeteredMetricNames returns the sorted metric names the queries select. An
+// error is returned if a query doesn't parse or selects metrics by something
+// else than their name, the names are then incomplete.
+func MeteredMetricNames(queries ...string) ([]string, error) {
+	names := map[string]bool{}
+	var errs error
+
+	for _, query := range queries {
+		expr, err := parser.ParseExpr(query)
+		if err != nil {
+			errs = errors.Append(errs, errors.WrapWithDetails(err, "failed to parse query", "query", query))
+			continue
+		}
+
+		parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+			vs, ok := node.(*parser.VectorSelector)
+			if !ok {
+				return nil
+			}
+
+			if vs.Name != "" {
+				names[vs.Name] = true
+				return nil
+			}
+
+			for _, matcher := range vs.LabelMatchers {
+				if matcher.Name == labels.MetricName && matcher.Type == labels.MatchEqual {
+					names[matcher.Value] = true
+					return nil
+				}
+			}
+
+			errs = errors.Append(errs, errors.WithDetails(
+				errors.WithMessage(UndeterminedMetricNames, vs.String()),
+				"query", query))
+			return nil
+		})
+	}
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+	return result, errs
+}
+
+// keepMetricsRelabelConfig keeps the series of the metrics and drops the
+// rest.
+func keepMetricsRelabelConfig(names []string) *monitoringv1.RelabelConfig {
+	return &monitoringv1.RelabelConfig{
+		SourceLabels: []string{model.MetricNameLabel},
+		Regex:        metricNamesRegex(names),
+		Action:       "keep",
+	}
+}
+
+// keepMetricsWithUsageRelabelConfig also keeps the container usage series
+// of the namespace.
+func keepMetricsWithUsageRelabelConfig(names []string, namespace string) *monitoringv1.RelabelConfig {
+	usage := metricNamesRegex([]string{ContainerCPUUsageMetric, ContainerMemoryUsageMetric})
+
+	return &monitoringv1.RelabelConfig{
+		SourceLabels: []string{model.MetricNameLabel, "namespace"},
+		Regex:        fmt.Sprintf("%s;.*|%s;%s", metricNamesRegex(names), usage, regexp.QuoteMeta(namespace)),
+		Action:       "keep",
+	}
+}
+
+func metricNamesRegex(names []string) string {
+	unique := map[string]bool{}
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		if !unique[name] {
+			unique[name] = true
+			quoted = append(quoted, regexp.QuoteMeta(name))
+		}
+	}
+
+	sort.Strings(quoted)
+
+	return fmt.Sprintf("(%s)", strings.Join(quoted, "|"))
+}
+
+// AllowlistServiceMonitor returns a copy of the monitor only keeping the
+// series of the metric names. The keep runs after the metric relabelings of
+// the monitor so renamed metrics are matched by their new name. Without names
+// every series is kept.
+func AllowlistServiceMonitor(mon *monitoringv1.ServiceMonitor, names []string) *monitoringv1.ServiceMonitor {
+	mon = mon.DeepCopy()
+
+	if len(names) == 0 {
+		return mon
+	}
+
+	for i := range mon.Spec.Endpoints {
+		ep := &mon.Spec.Endpoints[i]
+		ep.MetricRelabelConfigs = append(ep.MetricRelabelConfigs, keepMetricsRelabelConfig(names))
+	}
+
+	return mon
+}
+
+// AllowlistServiceMonitorWithUsage is AllowlistServiceMonitor also keeping
+// the container usage of the namespace, which the resource recommendations
+// of the metering components are computed from.
+func AllowlistServiceMonitorWithUsage(mon *monitoringv1.ServiceMonitor, names []string, namespace string) *monitoringv1.ServiceMonitor {
+	mon = mon.DeepCopy()
+
+	if len(names) == 0 {
+		return mon
+	}
+
+	for i := range mon.Spec.Endpoints {
+		ep := &mon.Spec.Endpoints[i]
+		ep.MetricRelabelConfigs = append(ep.MetricRelabelConfigs, keepMetricsWithUsageRelabelConfig(names, namespace))
+	}
+
+	return mon
+}
+
+// UnsafeScrapeTarget is returned for monitors with endpoints the metering
+// Prometheus can't scrape on behalf of the namespace of the monitor.
+const UnsafeScrapeTarget = errors.Sentinel("unsafe scrape target")
+
+// CheckServiceMonitor rejects a vendor monitor with endpoints reading files
+// from the metering Prometheus, like its service account token, or using
+// secrets. The monitors are merged into the additional scrape configs so
+// secrets are never mounted for them.
+func CheckServiceMonitor(mon *monitoringv1.ServiceMonitor) error {
+	var errs error
+
+	for i, ep := range mon.Spec.Endpoints {
+		fields := []string{}
+
+		if ep.BearerTokenFile != "" {
+			fields = append(fields, "bearerTokenFile")
+		}
+
+		if ep.BearerTokenSecret.Name != "" {
+			fields = append(fields, "bearerTokenSecret")
+		}
+
+		if ep.TLSConfig != nil {
+			if ep.TLSConfig.CAFile != "" {
+				fields = append(fields, "tlsConfig.caFile")
+			}
+
+			if ep.TLSConfig.CertFile != "" {
+				fields = append(fields, "tlsConfig.certFile")
+			}
+
+			if ep.TLSConfig.KeyFile != "" {
+				fields = append(fields, "tlsConfig.keyFile")
+			}
+
+			fields = append(fields, safeTLSConfigFields(&ep.TLSConfig.SafeTLSConfig)...)
+		}
+
+		errs = errors.Append(errs, unsafeEndpointError(i, fields))
+	}
+
+	return errs
+}
+
+// CheckPodMonitor is the PodMonitor counterpart of CheckServiceMonitor.
+func CheckPodMonitor(mon *monitoringv1.PodMonitor) error {
+	var errs error
+
+	for i, ep := range mon.Spec.PodMetricsEndpoints {
+		fields := []string{}
+
+		if ep.BearerTokenSecret.Name != "" {
+			fields = append(fields, "bearerTokenSecret")
+		}
+
+		if ep.TLSConfig != nil {
+			fields = append(fields, safeTLSConfigFields(&ep.TLSConfig.SafeTLSConfig)...)
+		}
+
+		errs = errors.Append(errs, unsafeEndpointError(i, fields))
+	}
+
+	return errs
+}
+
+func safeTLSConfigFields(tlsConfig *monitoringv1.SafeTLSConfig) []string {
+	fields := []string{}
+
+	if tlsConfig.CA != (monitoringv1.SecretOrConfigMap{}) {
+		fields = append(fields, "tlsConfig.ca")
+	}
+
+	if tlsConfig.Cert != (monitoringv1.SecretOrConfigMap{}) {
+		fields = append(fields, "tlsConfig.cert")
+	}
+
+	if tlsConfig.KeySecret != nil {
+		fields = append(fields, "tlsConfig.keySecret")
+	}
+
+	return fields
+}
+
+func unsafeEndpointError(endpoint int, fields []string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return errors.WithDetails(
+		errors.WithMessagef(UnsafeScrapeTarget, "endpoint %d sets %s", endpoint, strings.Join(fields, ", ")),
+		"endpoint", endpoint)
+}
+
+// MeteredServiceMonitor returns a copy of the vendor monitor that is safe to
+// merge into the metering config. Targets are limited to the namespace of
+// the monitor, scraped labels can't override the target labels the meters
+// are joined on and only the metric names are kept, every metric if there
+// are no names.
+func MeteredServiceMonitor(mon *monitoringv1.ServiceMonitor, names []string) *monitoringv1.ServiceMonitor {
+	mon = AllowlistServiceMonitor(mon, names)
+	mon.Spec.NamespaceSelector = monitoringv1.NamespaceSelector{MatchNames: []string{mon.Namespace}}
+
+	for i := range mon.Spec.Endpoints {
+		mon.Spec.Endpoints[i].HonorLabels = false
+	}
+
+	return mon
+}
+
+// MeteredPodMonitor is the PodMonitor counterpart of MeteredServiceMonitor.
+func MeteredPodMonitor(mon *monitoringv1.PodMonitor, names []string) *monitoringv1.PodMonitor {
+	mon = mon.DeepCopy()
+	mon.Spec.NamespaceSelector = monitoringv1.NamespaceSelector{MatchNames: []string{mon.Namespace}}
+
+	for i := range mon.Spec.PodMetricsEndpoints {
+		ep := &mon.Spec.PodMetricsEndpoints[i]
+		ep.HonorLabels = false
+
+		if len(names) != 0 {
+			ep.MetricRelabelConfigs = append(ep.MetricRelabelConfigs, keepMetricsRelabelConfig(names))
+		}
+	}
+
+	return mon
+}
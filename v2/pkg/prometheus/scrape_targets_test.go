@@ -0,0 +1,215 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"regexp"
+
+	"emperror.dev/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("Scrape targets", func() {
+	It("should find the metrics of the queries", func() {
+		names, err := MeteredMetricNames(
+			`sum(rate(vendor_requests_total{code="200"}[5m])) by (pod)`,
+			`{__name__="vendor_users"}`,
+			`vendor_requests_total`,
+		)
+
+		Expect(err).To(Succeed())
+		Expect(names).To(Equal([]string{"vendor_requests_total", "vendor_users"}))
+	})
+
+	It("should fail for selectors without a metric name", func() {
+		names, err := MeteredMetricNames(
+			`vendor_users`,
+			`{__name__=~"vendor_requests_.*"}`,
+			`sum({job="vendor"})`,
+		)
+
+		Expect(errors.Is(err, UndeterminedMetricNames)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(`{__name__=~"vendor_requests_.*"}`))
+		Expect(err.Error()).To(ContainSubstring(`{job="vendor"}`))
+		Expect(names).To(Equal([]string{"vendor_users"}))
+	})
+
+	It("should keep every metric without names", func() {
+		mon := &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-state-metrics", Namespace: "openshift-monitoring"},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{Port: "https-main"}},
+			},
+		}
+
+		Expect(AllowlistServiceMonitor(mon, nil).Spec.Endpoints[0].MetricRelabelConfigs).To(BeEmpty())
+		Expect(AllowlistServiceMonitorWithUsage(mon, []string{}, "openshift-redhat-marketplace").Spec.Endpoints[0].MetricRelabelConfigs).To(BeEmpty())
+		Expect(MeteredServiceMonitor(mon, nil).Spec.Endpoints[0].MetricRelabelConfigs).To(BeEmpty())
+	})
+
+	It("should skip queries that don't parse", func() {
+		names, err := MeteredMetricNames(`vendor_users`, `sum(`)
+
+		Expect(err).To(HaveOccurred())
+		Expect(names).To(Equal([]string{"vendor_users"}))
+	})
+
+	It("should reject monitors reading files or secrets", func() {
+		mon := &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: "vendor", Namespace: "vendor-ns"},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{
+					{Port: "metrics"},
+					{
+						Port:            "https-metrics",
+						BearerTokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token",
+						TLSConfig: &monitoringv1.TLSConfig{
+							CAFile: "/etc/prometheus/configmaps/serving-certs-ca-bundle/service-ca.crt",
+						},
+					},
+				},
+			},
+		}
+
+		err := CheckServiceMonitor(mon)
+		Expect(errors.Is(err, UnsafeScrapeTarget)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("endpoint 1 sets bearerTokenFile, tlsConfig.caFile"))
+
+		mon.Spec.Endpoints = mon.Spec.Endpoints[:1]
+		Expect(CheckServiceMonitor(mon)).To(Succeed())
+
+		podMon := &monitoringv1.PodMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: "vendor", Namespace: "vendor-ns"},
+			Spec: monitoringv1.PodMonitorSpec{
+				PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+					{
+						Port: "metrics",
+						BearerTokenSecret: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "vendor-token"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+		}
+
+		err = CheckPodMonitor(podMon)
+		Expect(errors.Is(err, UnsafeScrapeTarget)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("bearerTokenSecret"))
+	})
+
+	It("should keep the allowlist after the monitor relabelings", func() {
+		mon := &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-state-metrics", Namespace: "openshift-monitoring"},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						Port: "https-main",
+						MetricRelabelConfigs: []*monitoringv1.RelabelConfig{
+							{SourceLabels: []string{"__name__"}, Regex: "kube_secret_labels", Action: "drop"},
+						},
+					},
+					{Port: "https-self"},
+				},
+			},
+		}
+
+		allowlisted := AllowlistServiceMonitor(mon, []string{"kube_pod_info", "kube_persistentvolumeclaim_info"})
+
+		Expect(mon.Spec.Endpoints[0].MetricRelabelConfigs).To(HaveLen(1))
+		for _, ep := range allowlisted.Spec.Endpoints {
+			keep := ep.MetricRelabelConfigs[len(ep.MetricRelabelConfigs)-1]
+			Expect(keep.Action).To(Equal("keep"))
+			Expect(keep.Regex).To(Equal("(kube_persistentvolumeclaim_info|kube_pod_info)"))
+		}
+		Expect(allowlisted.Spec.Endpoints[0].MetricRelabelConfigs).To(HaveLen(2))
+	})
+
+	It("should keep the container usage of the namespace", func() {
+		mon := &monitoringv1.ServiceMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubelet", Namespace: "openshift-monitoring"},
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{Port: "https-metrics", Path: "/metrics/cadvisor"}},
+			},
+		}
+
+		allowlisted := AllowlistServiceMonitorWithUsage(mon, []string{"kube_pod_info"}, "openshift-redhat-marketplace")
+
+		Expect(mon.Spec.Endpoints[0].MetricRelabelConfigs).To(BeEmpty())
+		keep := allowlisted.Spec.Endpoints[0].MetricRelabelConfigs[0]
+		Expect(keep.Action).To(Equal("keep"))
+		Expect(keep.SourceLabels).To(Equal([]string{"__name__", "namespace"}))
+
+		// prometheus anchors the regex of relabel configs
+		regex := regexp.MustCompile("^(?:" + keep.Regex + ")$")
+		Expect(regex.MatchString("kube_pod_info;other")).To(BeTrue())
+		Expect(regex.MatchString("kube_pod_info;")).To(BeTrue())
+		Expect(regex.MatchString("container_memory_working_set_bytes;openshift-redhat-marketplace")).To(BeTrue())
+		Expect(regex.MatchString("container_cpu_usage_seconds_total;openshift-redhat-marketplace")).To(BeTrue())
+		Expect(regex.MatchString("container_cpu_usage_seconds_total;other")).To(BeFalse())
+		Expect(regex.MatchString("container_fs_reads_total;openshift-redhat-marketplace")).To(BeFalse())
+	})
+
+	It("should only keep the metered metrics of the vendor namespace", func() {
+		mon := &monitoringv1.PodMonitor{
+			ObjectMeta: metav1.ObjectMeta{Name: "vendor", Namespace: "vendor-ns"},
+			Spec: monitoringv1.PodMonitorSpec{
+				NamespaceSelector: monitoringv1.NamespaceSelector{Any: true},
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "vendor"},
+				},
+				PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+					{Port: "metrics", HonorLabels: true},
+				},
+			},
+		}
+
+		metered := MeteredPodMonitor(mon, []string{"vendor_users", "vendor.requests", "vendor_users"})
+
+		Expect(mon.Spec.NamespaceSelector.Any).To(BeTrue())
+		Expect(metered.Spec.NamespaceSelector.MatchNames).To(Equal([]string{"vendor-ns"}))
+
+		ep := metered.Spec.PodMetricsEndpoints[0]
+		Expect(ep.HonorLabels).To(BeFalse())
+		Expect(ep.MetricRelabelConfigs).To(HaveLen(1))
+		Expect(ep.MetricRelabelConfigs[0].Action).To(Equal("keep"))
+		Expect(ep.MetricRelabelConfigs[0].Regex).To(Equal(`(vendor\.requests|vendor_users)`))
+
+		p := &monitoringv1.Prometheus{
+			ObjectMeta: metav1.ObjectMeta{Name: "rhm-marketplaceconfig-meterbase", Namespace: "openshift-redhat-marketplace"},
+		}
+
+		cfg, err := NewConfigGenerator(logf.Log).GenerateConfig(
+			p,
+			map[string]*monitoringv1.ServiceMonitor{},
+			map[string]*monitoringv1.PodMonitor{"vendor": metered},
+			map[string]assets.BasicAuthCredentials{},
+			map[string]assets.BearerToken{},
+			[]string{},
+		)
+
+		Expect(err).To(Succeed())
+		Expect(string(cfg)).To(ContainSubstring("job_name: podMonitor/vendor-ns/vendor/0"))
+		Expect(string(cfg)).To(ContainSubstring("- vendor-ns"))
+		Expect(string(cfg)).To(ContainSubstring(`regex: (vendor\.requests|vendor_users)`))
+		Expect(string(cfg)).To(ContainSubstring("honor_labels: false"))
+	})
+})
@@ -17,6 +17,7 @@ package prometheus
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/gotidy/ptr"
@@ -37,8 +38,16 @@ type ServiceAccountClient struct {
 type Token struct {
 	AuthToken           *string
 	ExpirationTimestamp metav1.Time
+
+	serviceAccount string
+	audience       string
 }
 
+// tokenRefreshBefore is how long before it expires a token is replaced.
+const tokenRefreshBefore = 5 * time.Minute
+
+// NewServiceAccountToken returns a token of the service account for the
+// audience. The token is reused until it nears its expiry.
 func (s *ServiceAccountClient) NewServiceAccountToken(targetServiceAccountName string, audience string, expireSecs int64, reqLogger logr.Logger) (string, error) {
 	s.Lock()
 	defer s.Unlock()
@@ -53,14 +62,18 @@ func (s *ServiceAccountClient) NewServiceAccountToken(targetServiceAccountName s
 		return s.getToken(targetServiceAccountName, s.Client, tr, opts)
 	}
 
-	if now.UTC().After(s.Token.ExpirationTimestamp.Time) {
+	if s.Token.serviceAccount != targetServiceAccountName || s.Token.audience != audience {
+		return s.getToken(targetServiceAccountName, s.Client, tr, opts)
+	}
+
+	if now.Add(tokenRefreshBefore).After(s.Token.ExpirationTimestamp.Time) {
 
 		reqLogger.Info("service account token is expired")
 
 		return s.getToken(targetServiceAccountName, s.Client, tr, opts)
 	}
 
-	return s.getToken(targetServiceAccountName, s.Client, tr, opts)
+	return *s.Token.AuthToken, nil
 }
 
 func NewServiceAccountClient(namespace string, kubernetesInterface kubernetes.Interface) *ServiceAccountClient {
@@ -79,6 +92,7 @@ func (s *ServiceAccountClient) newTokenRequest(audience string, expireSeconds in
 }
 
 func (s *ServiceAccountClient) getToken(targetServiceAccount string, client typedv1.ServiceAccountInterface, tr *authv1.TokenRequest, opts metav1.CreateOptions) (string, error) {
+	audience := tr.Spec.Audiences[0]
 	tr, err := client.CreateToken(context.TODO(), targetServiceAccount, tr, opts)
 	if err != nil {
 		return "", err
@@ -87,6 +101,8 @@ func (s *ServiceAccountClient) getToken(targetServiceAccount string, client type
 	s.Token = &Token{
 		AuthToken:           ptr.String(tr.Status.Token),
 		ExpirationTimestamp: tr.Status.ExpirationTimestamp,
+		serviceAccount:      targetServiceAccount,
+		audience:            audience,
 	}
 
 	token := tr.Status.Token
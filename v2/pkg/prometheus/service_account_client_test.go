@@ -0,0 +1,81 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	authv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var _ = Describe("ServiceAccountClient", func() {
+	var (
+		sut       *ServiceAccountClient
+		requests  int
+		expiresIn time.Duration
+	)
+
+	BeforeEach(func() {
+		requests = 0
+		expiresIn = time.Hour
+
+		kubeClient := fake.NewSimpleClientset()
+		kubeClient.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			requests = requests + 1
+			return true, &authv1.TokenRequest{
+				Status: authv1.TokenRequestStatus{
+					Token:               fmt.Sprintf("token-%d", requests),
+					ExpirationTimestamp: metav1.NewTime(time.Now().Add(expiresIn)),
+				},
+			}, nil
+		})
+
+		sut = NewServiceAccountClient("openshift-redhat-marketplace", kubeClient)
+	})
+
+	It("should reuse the token until it nears its expiry", func() {
+		token, err := sut.NewServiceAccountToken("operator", "prometheus", 3600, logf.Log)
+		Expect(err).To(Succeed())
+		Expect(token).To(Equal("token-1"))
+
+		token, err = sut.NewServiceAccountToken("operator", "prometheus", 3600, logf.Log)
+		Expect(err).To(Succeed())
+		Expect(token).To(Equal("token-1"))
+		Expect(requests).To(Equal(1))
+
+		sut.Token.ExpirationTimestamp = metav1.NewTime(time.Now().Add(time.Minute))
+
+		token, err = sut.NewServiceAccountToken("operator", "prometheus", 3600, logf.Log)
+		Expect(err).To(Succeed())
+		Expect(token).To(Equal("token-2"))
+	})
+
+	It("should request a token for another audience", func() {
+		_, err := sut.NewServiceAccountToken("operator", "prometheus", 3600, logf.Log)
+		Expect(err).To(Succeed())
+
+		token, err := sut.NewServiceAccountToken("operator", "thanos", 3600, logf.Log)
+		Expect(err).To(Succeed())
+		Expect(token).To(Equal("token-2"))
+	})
+})
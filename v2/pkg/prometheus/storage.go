@@ -0,0 +1,95 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"emperror.dev/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// BytesPerSample is the size of a sample on disk. Prometheus compresses
+	// samples to 1-2 bytes, the upper bound leaves room for compaction.
+	BytesPerSample = 2
+
+	// DefaultScrapeInterval is the scrape interval of the Prometheus
+	// operator if the Prometheus does not set one.
+	DefaultScrapeInterval = 30 * time.Second
+)
+
+// HeadStats are the stats of the in-memory block of the TSDB.
+type HeadStats struct {
+	NumSeries  uint64 `json:"numSeries"`
+	ChunkCount int64  `json:"chunkCount"`
+	MinTime    int64  `json:"minTime"`
+	MaxTime    int64  `json:"maxTime"`
+}
+
+type tsdbStatusResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		HeadStats HeadStats `json:"headStats"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
+// HeadStats returns the head stats of the TSDB. They are not part of the v1
+// api of the client, so the status endpoint is read directly.
+func (p *PrometheusAPI) HeadStats(ctx context.Context) (*HeadStats, error) {
+	if p.client == nil {
+		return nil, errors.New("prometheus client not defined")
+	}
+
+	u := p.client.URL("/api/v1/status/tsdb", nil)
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, body, err := p.client.Do(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get tsdb status")
+	}
+
+	status := tsdbStatusResponse{}
+
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, errors.WrapWithDetails(err, "failed to parse tsdb status", "code", resp.StatusCode)
+	}
+
+	if status.Status != "success" {
+		return nil, errors.NewWithDetails("failed to get tsdb status", "code", resp.StatusCode, "error", status.Error)
+	}
+
+	return &status.Data.HeadStats, nil
+}
+
+// EstimateStorage returns the storage needed to keep the samples of series
+// scraped every scrapeInterval for the retention.
+func EstimateStorage(series uint64, scrapeInterval, retention time.Duration) resource.Quantity {
+	if scrapeInterval <= 0 {
+		scrapeInterval = DefaultScrapeInterval
+	}
+
+	samples := int64(series) * int64(retention/scrapeInterval)
+
+	return *resource.NewQuantity(samples*BytesPerSample, resource.BinarySI)
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/api"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("Storage", func() {
+	It("should estimate the storage for the retention", func() {
+		// 10k series scraped every 30s for 30 days
+		estimate := EstimateStorage(10000, 30*time.Second, 30*24*time.Hour)
+		Expect(estimate.Value()).To(Equal(int64(10000 * 2880 * 30 * BytesPerSample)))
+		Expect(estimate.Cmp(resource.MustParse("2Gi"))).To(Equal(-1))
+		Expect(estimate.Cmp(resource.MustParse("1Gi"))).To(Equal(1))
+
+		Expect(EstimateStorage(10000, 0, 30*24*time.Hour)).To(Equal(estimate))
+	})
+
+	It("should read the head stats", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			Expect(req.URL.Path).To(Equal("/api/v1/status/tsdb"))
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"success","data":{"headStats":{"numSeries":508,"chunkCount":937,"minTime":1591516800000,"maxTime":1598896800143},"seriesCountByMetricName":[]}}`))
+		}))
+		defer server.Close()
+
+		client, err := api.NewClient(api.Config{Address: server.URL})
+		Expect(err).To(Succeed())
+
+		sut := &PrometheusAPI{client: client}
+		stats, err := sut.HeadStats(context.TODO())
+
+		Expect(err).To(Succeed())
+		Expect(stats.NumSeries).To(Equal(uint64(508)))
+		Expect(stats.ChunkCount).To(Equal(int64(937)))
+	})
+
+	It("should fail without a client", func() {
+		_, err := (&PrometheusAPI{}).HeadStats(context.TODO())
+		Expect(err).To(HaveOccurred())
+	})
+})
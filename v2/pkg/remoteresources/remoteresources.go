@@ -0,0 +1,182 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package remoteresources fetches the content of the RemoteResourceS3
+// requests and verifies it before it's applied, so only content signed by
+// the marketplace, and the pinned content if a digest is pinned, reaches
+// the cluster.
+package remoteresources
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/signer"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ErrRefused is the cause of the errors of content that fails
+// verification.
+const ErrRefused = errors.Sentinel("content refused")
+
+// maxContentSize is the largest content fetched for a request.
+const maxContentSize = 10 << 20
+
+// Fetcher fetches the content of the requests.
+type Fetcher struct {
+	httpClient *http.Client
+}
+
+// NewFetcher returns a fetcher calling out through the transport.
+func NewFetcher(transport http.RoundTripper) *Fetcher {
+	return &Fetcher{
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   time.Minute,
+		},
+	}
+}
+
+// IAMToken exchanges the api key for an access token of the IAM endpoint.
+func (f *Fetcher) IAMToken(ctx context.Context, iam *marketplacev1alpha1.Iam, apiKey string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", iam.GrantType)
+	form.Set("apikey", apiKey)
+	form.Set("response_type", iam.ResponseType)
+
+	req, err := http.NewRequest(http.MethodPost, iam.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to build token request")
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	body, err := f.do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get iam token")
+	}
+
+	token := struct {
+		AccessToken string `json:"access_token"`
+	}{}
+
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal iam token")
+	}
+
+	if token.AccessToken == "" {
+		return "", errors.New("iam token response has no access token")
+	}
+
+	return token.AccessToken, nil
+}
+
+// Fetch returns the content of the URL.
+func (f *Fetcher) Fetch(ctx context.Context, contentURL string, header http.Header) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, contentURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build request")
+	}
+
+	req = req.WithContext(ctx)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	return f.do(req)
+}
+
+func (f *Fetcher) do(req *http.Request) ([]byte, error) {
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxContentSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response")
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.WithDetails(errors.New("request failed"), "status", resp.StatusCode)
+	}
+
+	if len(body) > maxContentSize {
+		return nil, errors.Errorf("content is larger than %d bytes", maxContentSize)
+	}
+
+	return body, nil
+}
+
+// Digest returns the digest of the content as sha256:<hex>.
+func Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// Verify returns the objects of the content, the items of lists
+// flattened, once the content has the pinned digest, if one is pinned, and
+// every object is signed as a whole by a certificate of the CA, so none of
+// the fields applied can differ from what was signed. Content failing
+// verification returns an error caused by ErrRefused.
+func Verify(content []byte, pinnedDigest string, caCert *x509.Certificate) ([]unstructured.Unstructured, error) {
+	if digest := Digest(content); pinnedDigest != "" && digest != pinnedDigest {
+		return nil, errors.Wrapf(ErrRefused, "digest %s is not the pinned digest %s", digest, pinnedDigest)
+	}
+
+	decoded, err := signer.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, errors.Wrapf(ErrRefused, "failed to decode content: %s", err)
+	}
+
+	if err := signer.VerifyObjectSignatureArray(decoded, caCert); err != nil {
+		return nil, errors.Wrapf(ErrRefused, "signature is not valid: %s", err)
+	}
+
+	objs := []unstructured.Unstructured{}
+	for _, obj := range decoded {
+		if !obj.IsList() {
+			objs = append(objs, obj)
+			continue
+		}
+
+		list, err := obj.ToList()
+		if err != nil {
+			return nil, errors.Wrapf(ErrRefused, "failed to decode list: %s", err)
+		}
+
+		objs = append(objs, list.Items...)
+	}
+
+	if len(objs) == 0 {
+		return nil, errors.Wrap(ErrRefused, "content has no resources")
+	}
+
+	return objs, nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remoteresources
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"emperror.dev/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/signer"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// newCertificate returns a certificate signed by the parent, self signed
+// without one.
+func newCertificate(name string, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).To(Succeed())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	Expect(err).To(Succeed())
+
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).To(Succeed())
+
+	return cert, key
+}
+
+func configMap(name string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": name},
+		"data":       map[string]interface{}{"value": name},
+	}}
+}
+
+var _ = Describe("RemoteResources", func() {
+	var (
+		caCert  *x509.Certificate
+		content func(objs ...unstructured.Unstructured) []byte
+	)
+
+	BeforeEach(func() {
+		var caKey *rsa.PrivateKey
+		caCert, caKey = newCertificate("ca", nil, nil)
+		cert, key := newCertificate("signer", caCert, caKey)
+		certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+
+		content = func(objs ...unstructured.Unstructured) []byte {
+			list := &unstructured.UnstructuredList{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "List",
+			}}
+
+			for i := range objs {
+				Expect(signer.SignObject(&objs[i], certPEM, key)).To(Succeed())
+				list.Items = append(list.Items, objs[i])
+			}
+
+			data, err := list.MarshalJSON()
+			Expect(err).To(Succeed())
+
+			data, err = yaml.JSONToYAML(data)
+			Expect(err).To(Succeed())
+			return data
+		}
+	})
+
+	It("should verify signed content", func() {
+		signed := content(configMap("a"), configMap("b"))
+
+		objs, err := Verify(signed, "", caCert)
+		Expect(err).To(Succeed())
+		Expect(objs).To(HaveLen(2))
+		Expect(objs[1].GetName()).To(Equal("b"))
+
+		_, err = Verify(signed, Digest(signed), caCert)
+		Expect(err).To(Succeed())
+	})
+
+	It("should refuse content failing verification", func() {
+		signed := content(configMap("a"))
+
+		_, err := Verify(signed, Digest([]byte("expected")), caCert)
+		Expect(errors.Is(err, ErrRefused)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("is not the pinned digest"))
+
+		otherCA, _ := newCertificate("other", nil, nil)
+		_, err = Verify(signed, "", otherCA)
+		Expect(errors.Is(err, ErrRefused)).To(BeTrue())
+
+		tamper := func(change func(obj *unstructured.Unstructured)) []byte {
+			data, err := yaml.YAMLToJSON(signed)
+			Expect(err).To(Succeed())
+
+			list := &unstructured.UnstructuredList{}
+			Expect(list.UnmarshalJSON(data)).To(Succeed())
+			change(&list.Items[0])
+
+			data, err = list.MarshalJSON()
+			Expect(err).To(Succeed())
+			return data
+		}
+
+		_, err = Verify(tamper(func(obj *unstructured.Unstructured) {}), "", caCert)
+		Expect(err).To(Succeed())
+
+		for _, change := range []func(obj *unstructured.Unstructured){
+			func(obj *unstructured.Unstructured) {
+				obj.Object["data"] = map[string]interface{}{"value": "tampered"}
+			},
+			func(obj *unstructured.Unstructured) {
+				obj.SetName("tampered")
+			},
+			func(obj *unstructured.Unstructured) {
+				obj.SetNamespace("kube-system")
+			},
+			func(obj *unstructured.Unstructured) {
+				obj.SetLabels(map[string]string{"tampered": "true"})
+			},
+			func(obj *unstructured.Unstructured) {
+				obj.SetKind("Secret")
+			},
+		} {
+			_, err = Verify(tamper(change), "", caCert)
+			Expect(errors.Is(err, ErrRefused)).To(BeTrue())
+		}
+
+		unsigned := configMap("a")
+		data, err := unsigned.MarshalJSON()
+		Expect(err).To(Succeed())
+
+		_, err = Verify(data, "", caCert)
+		Expect(errors.Is(err, ErrRefused)).To(BeTrue())
+
+		_, err = Verify([]byte{}, "", caCert)
+		Expect(errors.Is(err, ErrRefused)).To(BeTrue())
+	})
+
+	It("should fetch the content with an iam token", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			switch req.URL.Path {
+			case "/identity/token":
+				Expect(req.ParseForm()).To(Succeed())
+				Expect(req.PostForm.Get("apikey")).To(Equal("api-key"))
+				Expect(req.PostForm.Get("grant_type")).To(Equal("urn:ibm:params:oauth:grant-type:apikey"))
+				_, _ = w.Write([]byte(`{"access_token":"token"}`))
+			case "/bucket/child.yaml":
+				if req.Header.Get("Authorization") != "Bearer token" {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				_, _ = w.Write([]byte("content"))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		fetcher := NewFetcher(http.DefaultTransport)
+
+		token, err := fetcher.IAMToken(context.TODO(), &marketplacev1alpha1.Iam{
+			ResponseType: "cloud_iam",
+			GrantType:    "urn:ibm:params:oauth:grant-type:apikey",
+			URL:          server.URL + "/identity/token",
+		}, "api-key")
+		Expect(err).To(Succeed())
+		Expect(token).To(Equal("token"))
+
+		_, err = fetcher.Fetch(context.TODO(), server.URL+"/bucket/child.yaml", nil)
+		Expect(err).ToNot(Succeed())
+
+		body, err := fetcher.Fetch(context.TODO(), server.URL+"/bucket/child.yaml", http.Header{
+			"Authorization": []string{"Bearer " + token},
+		})
+		Expect(err).To(Succeed())
+		Expect(string(body)).To(Equal("content"))
+	})
+})
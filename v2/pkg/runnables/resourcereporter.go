@@ -0,0 +1,244 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runnables
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/go-logr/logr"
+	marketplacev1alpha1 "github.com/redhat-marketplace/redhat-marketplace-operator/v2/apis/marketplace/v1alpha1"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/config"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/features"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/inventory"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/utils/reconcileutils"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+// ResourceReporter reports the resources labelled for razee to razeedash
+// while the NativeResourceReporter feature is enabled, the RazeeDeployment
+// no longer deploys the watch-keeper then.
+type ResourceReporter struct {
+	Logger logr.Logger
+	CC     reconcileutils.ClientCommandRunner
+	Config *config.OperatorConfig
+	Rest   *rest.Config
+}
+
+// resourceReporterRecheck is how often the RazeeDeployment is checked for
+// a new razeedash config.
+const resourceReporterRecheck = time.Minute
+
+func (a *ResourceReporter) NeedLeaderElection() bool {
+	return true
+}
+
+func (a *ResourceReporter) Start(stop <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := a.Logger.WithValues("function", "resourceReporter")
+	changes := a.Config.FeatureGate.Notify()
+
+	ticker := time.NewTicker(resourceReporterRecheck)
+	defer ticker.Stop()
+
+	var running resourceReporterState
+	stopReporter := func() {}
+
+	for {
+		desired := resourceReporterState{}
+
+		if a.Config.FeatureGate.Enabled(features.NativeResourceReporter) &&
+			a.Config.FeatureGate.Enabled(features.Registration) {
+			var err error
+			desired, err = a.desiredState(ctx)
+			if err != nil {
+				logger.Error(err, "failed to get razeedash config")
+				desired = running
+			}
+		}
+
+		if !reflect.DeepEqual(desired, running) {
+			stopReporter()
+			stopReporter = func() {}
+			running = desired
+
+			if desired.config.IsValid() {
+				logger.Info("starting resource reporter", "url", desired.config.URL, "cluster", desired.config.ClusterID)
+
+				reporterCtx, cancelReporter := context.WithCancel(ctx)
+				if err := a.run(reporterCtx, desired, logger); err != nil {
+					logger.Error(err, "failed to start resource reporter")
+					running = resourceReporterState{}
+				}
+				stopReporter = cancelReporter
+			} else {
+				logger.Info("resource reporter stopped")
+			}
+		}
+
+		select {
+		case <-stop:
+			stopReporter()
+			return nil
+		case <-changes:
+		case <-ticker.C:
+		}
+	}
+}
+
+// resourceReporterState is what the reporter runs with, it restarts when
+// the state changes.
+type resourceReporterState struct {
+	config inventory.Config
+	// kinds are the kinds of the watch resource policy.
+	kinds []schema.GroupVersionKind
+}
+
+func (a *ResourceReporter) run(ctx context.Context, state resourceReporterState, logger logr.Logger) error {
+	dynamicClient, err := dynamic.NewForConfig(a.Rest)
+	if err != nil {
+		return errors.Wrap(err, "failed to build dynamic client")
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(a.Rest)
+	if err != nil {
+		return errors.Wrap(err, "failed to build discovery client")
+	}
+
+	policyResources, err := inventory.KindResources(discoveryClient, state.kinds)
+	if err != nil {
+		return err
+	}
+
+	resources, err := inventory.ServedResources(discoveryClient, append(policyResources, inventory.Resources...))
+	if err != nil {
+		return err
+	}
+
+	transport, err := a.Config.Outbound.NewTransport()
+	if err != nil {
+		return errors.Wrap(err, "failed to build transport")
+	}
+
+	reporterConfig := a.Config.ResourceReporter
+	reporter := inventory.NewBatcher(
+		inventory.NewClient(state.config, transport),
+		reporterConfig.BatchSize,
+		reporterConfig.FlushInterval,
+		rate.Limit(reporterConfig.RateLimit),
+		logger,
+	)
+
+	go reporter.Run(ctx)
+	go func() {
+		if err := inventory.Watch(ctx, dynamicClient, resources, reporterConfig.PollInterval, reporter); err != nil {
+			logger.Error(err, "failed to watch resources")
+		}
+	}()
+
+	return nil
+}
+
+// desiredState returns the razeedash config and the kinds of the watch
+// resource policy.
+func (a *ResourceReporter) desiredState(ctx context.Context) (resourceReporterState, error) {
+	config, err := a.razeeDashConfig(ctx)
+	if err != nil {
+		return resourceReporterState{}, err
+	}
+
+	marketplaceConfig := &marketplacev1alpha1.MarketplaceConfig{}
+
+	result, _ := a.CC.Exec(ctx, reconcileutils.Do(
+		reconcileutils.GetAction(types.NamespacedName{
+			Name:      utils.MARKETPLACECONFIG_NAME,
+			Namespace: a.Config.DeployedNamespace,
+		}, marketplaceConfig),
+	))
+
+	if !result.Is(reconcileutils.Continue) && !result.Is(reconcileutils.NotFound) {
+		return resourceReporterState{}, errors.Wrap(result.GetError(), "failed to get marketplaceconfig")
+	}
+
+	kinds := []schema.GroupVersionKind{}
+	for _, rule := range marketplaceConfig.WatchResourceRules() {
+		kinds = append(kinds, schema.FromAPIVersionAndKind(rule.APIVersion, rule.Kind))
+	}
+
+	return resourceReporterState{config: config, kinds: kinds}, nil
+}
+
+// razeeDashConfig returns the razeedash config of the RazeeDeployment, an
+// empty config if razee isn't configured yet.
+func (a *ResourceReporter) razeeDashConfig(ctx context.Context) (inventory.Config, error) {
+	razee := &marketplacev1alpha1.RazeeDeployment{}
+	secret := &corev1.Secret{}
+
+	result, _ := a.CC.Exec(ctx, reconcileutils.Do(
+		reconcileutils.GetAction(types.NamespacedName{
+			Name:      utils.RAZEE_NAME,
+			Namespace: a.Config.DeployedNamespace,
+		}, razee),
+	))
+
+	if result.Is(reconcileutils.NotFound) {
+		return inventory.Config{}, nil
+	}
+
+	if !result.Is(reconcileutils.Continue) {
+		return inventory.Config{}, errors.Wrap(result.GetError(), "failed to get razeedeployment")
+	}
+
+	if !razee.Spec.Enabled || razee.Spec.DeployConfig == nil || razee.Spec.DeployConfig.RazeeDashOrgKey == nil {
+		return inventory.Config{}, nil
+	}
+
+	result, _ = a.CC.Exec(ctx, reconcileutils.Do(
+		reconcileutils.GetAction(types.NamespacedName{
+			Name:      utils.RHM_OPERATOR_SECRET_NAME,
+			Namespace: a.Config.DeployedNamespace,
+		}, secret),
+	))
+
+	if result.Is(reconcileutils.NotFound) {
+		return inventory.Config{}, nil
+	}
+
+	if !result.Is(reconcileutils.Continue) {
+		return inventory.Config{}, errors.Wrap(result.GetError(), "failed to get operator secret")
+	}
+
+	orgKey, err := utils.ExtractCredKey(secret, *razee.Spec.DeployConfig.RazeeDashOrgKey)
+	if err != nil {
+		return inventory.Config{}, errors.Wrap(err, "failed to get razeedash org key")
+	}
+
+	return inventory.Config{
+		URL:       razee.Spec.DeployConfig.RazeeDashUrl,
+		ClusterID: razee.Spec.ClusterUUID,
+		OrgKey:    string(orgKey),
+	}, nil
+}
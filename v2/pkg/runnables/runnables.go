@@ -24,12 +24,15 @@ type Runnables []manager.Runnable
 var RunnableSet = wire.NewSet(
 	ProvideRunnables,
 	wire.Struct(new(CRDUpdater), "*"),
+	wire.Struct(new(ResourceReporter), "*"),
 )
 
 func ProvideRunnables(
 	crdUpdater *CRDUpdater,
+	resourceReporter *ResourceReporter,
 ) Runnables {
 	return []manager.Runnable{
 		crdUpdater,
+		resourceReporter,
 	}
 }
@@ -0,0 +1,215 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package transport builds the transport of every outbound http client so
+// they honour the same proxy, trusted certificate authorities and client
+// certificate.
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/caarlos0/env/v6"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	"golang.org/x/net/http/httpproxy"
+	"golang.org/x/net/http2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("transport")
+
+// ClusterProxyName is the name of the cluster wide OpenShift proxy.
+const ClusterProxyName = "cluster"
+
+// Config configures the transport of outbound clients. A config without
+// proxies connects directly.
+type Config struct {
+	HTTPProxy  string `env:"HTTP_PROXY"`
+	HTTPSProxy string `env:"HTTPS_PROXY"`
+	NoProxy    string `env:"NO_PROXY"`
+
+	// CAFiles are trusted in addition to the system pool. Files that don't
+	// exist are skipped, the trusted ca bundle config map is only injected
+	// on OpenShift.
+	CAFiles []string `env:"OUTBOUND_CA_FILES"`
+
+	// ClientCertFile and ClientKeyFile are the client certificate presented
+	// to servers requiring mTLS.
+	ClientCertFile string `env:"OUTBOUND_CLIENT_CERT_FILE"`
+	ClientKeyFile  string `env:"OUTBOUND_CLIENT_KEY_FILE"`
+
+	// CAData and Certificates are set by clients with certificates of their
+	// own, i.e. read from secrets.
+	CAData       [][]byte
+	Certificates []tls.Certificate
+
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// FromEnv returns the config set in the environment.
+func FromEnv() (*Config, error) {
+	c := &Config{}
+	if err := env.Parse(c); err != nil {
+		return nil, errors.Wrap(err, "failed to parse transport config")
+	}
+
+	return c, nil
+}
+
+// WithClusterProxy fills in the proxies of the OpenShift cluster proxy if
+// none are configured. Clusters without a proxy are left as they are.
+func (c *Config) WithClusterProxy(ctx context.Context, reader client.Reader) error {
+	if c.HasProxy() {
+		return nil
+	}
+
+	proxy := &openshiftconfigv1.Proxy{}
+	err := reader.Get(ctx, client.ObjectKey{Name: ClusterProxyName}, proxy)
+
+	if k8serrors.IsNotFound(err) || meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) {
+		return nil
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "failed to get cluster proxy")
+	}
+
+	c.SetClusterProxy(proxy)
+	return nil
+}
+
+// SetClusterProxy sets the proxies in effect for the OpenShift cluster
+// proxy.
+func (c *Config) SetClusterProxy(proxy *openshiftconfigv1.Proxy) {
+	c.HTTPProxy = proxy.Status.HTTPProxy
+	c.HTTPSProxy = proxy.Status.HTTPSProxy
+	c.NoProxy = proxy.Status.NoProxy
+}
+
+// HasProxy returns true if a proxy is configured.
+func (c *Config) HasProxy() bool {
+	return c.HTTPProxy != "" || c.HTTPSProxy != ""
+}
+
+// ProxyFunc returns the proxy of a request.
+func (c *Config) ProxyFunc() func(*http.Request) (*url.URL, error) {
+	if !c.HasProxy() {
+		return nil
+	}
+
+	proxyFunc := (&httpproxy.Config{
+		HTTPProxy:  c.HTTPProxy,
+		HTTPSProxy: c.HTTPSProxy,
+		NoProxy:    c.NoProxy,
+	}).ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+}
+
+// TLSConfig returns the tls config trusting the system pool and the
+// configured certificate authorities.
+func (c *Config) TLSConfig() (*tls.Config, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get system cert pool")
+	}
+
+	for _, file := range c.CAFiles {
+		ca, err := ioutil.ReadFile(file)
+
+		if os.IsNotExist(err) {
+			log.V(2).Info("skipping missing ca file", "file", file)
+			continue
+		}
+
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load cert file")
+		}
+
+		pool.AppendCertsFromPEM(ca)
+	}
+
+	for _, ca := range c.CAData {
+		pool.AppendCertsFromPEM(ca)
+	}
+
+	certificates := append([]tls.Certificate{}, c.Certificates...)
+
+	if c.ClientCertFile != "" || c.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.ClientCertFile, c.ClientKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load client certificate")
+		}
+		certificates = append(certificates, cert)
+	}
+
+	return &tls.Config{
+		RootCAs:            pool,
+		Certificates:       certificates,
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}, nil
+}
+
+// NewTransport returns a transport with the proxy and tls config.
+func (c *Config) NewTransport() (*http.Transport, error) {
+	tlsConfig, err := c.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Transport{
+		Proxy: c.ProxyFunc(),
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:       tlsConfig,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}, nil
+}
+
+// NewHTTP2Transport returns a transport that uses HTTP/2 when the server
+// supports it. Unlike an http2.Transport it still tunnels through the proxy.
+func (c *Config) NewHTTP2Transport() (*http.Transport, error) {
+	transport, err := c.NewTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := http2.ConfigureTransport(transport); err != nil {
+		return nil, errors.Wrap(err, "failed to configure http2")
+	}
+
+	return transport, nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Transport", func() {
+	proxyOf := func(c *Config, rawURL string) *url.URL {
+		u, err := url.Parse(rawURL)
+		Expect(err).To(Succeed())
+
+		proxy, err := c.ProxyFunc()(&http.Request{URL: u})
+		Expect(err).To(Succeed())
+		return proxy
+	}
+
+	It("should connect directly without proxies", func() {
+		Expect((&Config{}).ProxyFunc()).To(BeNil())
+	})
+
+	It("should proxy by scheme except for no proxy hosts", func() {
+		c := &Config{
+			HTTPProxy:  "http://proxy:3128",
+			HTTPSProxy: "http://secure-proxy:3128",
+			NoProxy:    ".svc,.cluster.local",
+		}
+
+		Expect(proxyOf(c, "http://marketplace.redhat.com").Host).To(Equal("proxy:3128"))
+		Expect(proxyOf(c, "https://marketplace.redhat.com").Host).To(Equal("secure-proxy:3128"))
+		Expect(proxyOf(c, "https://prometheus.openshift-redhat-marketplace.svc:9091")).To(BeNil())
+	})
+
+	Describe("cluster proxy", func() {
+		var s *runtime.Scheme
+
+		BeforeEach(func() {
+			s = runtime.NewScheme()
+			Expect(openshiftconfigv1.AddToScheme(s)).To(Succeed())
+		})
+
+		proxy := &openshiftconfigv1.Proxy{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterProxyName},
+			Status: openshiftconfigv1.ProxyStatus{
+				HTTPProxy:  "http://proxy:3128",
+				HTTPSProxy: "http://proxy:3128",
+				NoProxy:    ".svc",
+			},
+		}
+
+		It("should use the proxy of the cluster", func() {
+			c := &Config{}
+			Expect(c.WithClusterProxy(context.TODO(), fake.NewFakeClientWithScheme(s, proxy))).To(Succeed())
+
+			Expect(c.HTTPSProxy).To(Equal("http://proxy:3128"))
+			Expect(c.NoProxy).To(Equal(".svc"))
+		})
+
+		It("should prefer the configured proxy", func() {
+			c := &Config{HTTPSProxy: "http://configured:3128"}
+			Expect(c.WithClusterProxy(context.TODO(), fake.NewFakeClientWithScheme(s, proxy))).To(Succeed())
+
+			Expect(c.HTTPSProxy).To(Equal("http://configured:3128"))
+			Expect(c.NoProxy).To(BeEmpty())
+		})
+
+		It("should connect directly without a cluster proxy", func() {
+			c := &Config{}
+			Expect(c.WithClusterProxy(context.TODO(), fake.NewFakeClientWithScheme(s))).To(Succeed())
+			Expect(c.WithClusterProxy(context.TODO(), fake.NewFakeClientWithScheme(runtime.NewScheme()))).To(Succeed())
+
+			Expect(c.HasProxy()).To(BeFalse())
+		})
+	})
+
+	It("should send requests through the proxy", func() {
+		proxied := make(chan string, 1)
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			proxied <- req.URL.String()
+		}))
+		defer proxy.Close()
+
+		c := &Config{HTTPProxy: proxy.URL}
+		transport, err := c.NewTransport()
+		Expect(err).To(Succeed())
+
+		resp, err := (&http.Client{Transport: transport}).Get("http://marketplace.redhat.com/provisioning")
+		Expect(err).To(Succeed())
+		resp.Body.Close()
+
+		Expect(<-proxied).To(Equal("http://marketplace.redhat.com/provisioning"))
+	})
+
+	It("should trust the ca and present the client certificate", func() {
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if len(req.TLS.PeerCertificates) == 0 {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}))
+		server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+		server.StartTLS()
+		defer server.Close()
+
+		c := &Config{
+			CAData: [][]byte{pem.EncodeToMemory(&pem.Block{
+				Type:  "CERTIFICATE",
+				Bytes: server.Certificate().Raw,
+			})},
+			Certificates: server.TLS.Certificates,
+			CAFiles:      []string{"/does/not/exist.crt"},
+		}
+
+		transport, err := c.NewHTTP2Transport()
+		Expect(err).To(Succeed())
+
+		resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+		Expect(err).To(Succeed())
+		resp.Body.Close()
+
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	})
+})
@@ -47,12 +47,13 @@ const (
 	CONTROLLER_FINALIZER = "finalizer.marketplace.redhat.com"
 
 	/* RBAC */
-	CLUSTER_ROLE              = "redhat-marketplace-operator"
-	CLUSTER_ROLE_BINDING      = "redhat-marketplace-operator"
-	OPERATOR_SERVICE_ACCOUNT  = "redhat-marketplace-operator"
-	RAZEE_SERVICE_ACCOUNT     = "redhat-marketplace-razeedeploy"
-	METERBASE_SERVICE_ACCOUNT = "redhat-marketplace-metering"
-	REPORTING_SERVICE_ACCOUNT = "redhat-marketplace-reporting"
+	CLUSTER_ROLE                       = "redhat-marketplace-operator"
+	CLUSTER_ROLE_BINDING               = "redhat-marketplace-operator"
+	OPERATOR_SERVICE_ACCOUNT           = "redhat-marketplace-operator"
+	RAZEE_SERVICE_ACCOUNT              = "redhat-marketplace-razeedeploy"
+	METERBASE_SERVICE_ACCOUNT          = "redhat-marketplace-metering"
+	REPORTING_SERVICE_ACCOUNT          = "redhat-marketplace-reporting"
+	REMOTE_RESOURCE_S3_SERVICE_ACCOUNT = "redhat-marketplace-remoteresources3deployment"
 
 	/* Razee Controller Values */
 	RAZEE_DEPLOYMENT_FINALIZER                = "razeedeploy.finalizer.marketplace.redhat.com"
@@ -84,6 +85,10 @@ const (
 	RazeeWatchLevelLite   = "lite"
 	RazeeWatchLevelDetail = "detail"
 
+	// RazeeWatchResourcePolicy marks the resources labelled by the watch
+	// resource policy of the MarketplaceConfig.
+	RazeeWatchResourcePolicy = "marketplace.redhat.com/watch-resource-policy"
+
 	LicenseServerTag = "marketplace.redhat.com/operator"
 
 	/* Time and Date */
@@ -127,3 +132,8 @@ func HasMapKey(inMap map[string]string, a []string) bool {
 	_, ok := inMap[key]
 	return ok
 }
+
+func RemoveMapKey(inMap map[string]string, a []string) {
+	key, _ := GetMapKeyValue(a)
+	delete(inMap, key)
+}
@@ -19,6 +19,7 @@ package signer
 import (
 	"bytes"
 	"crypto"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
@@ -36,6 +37,11 @@ import (
 
 const SignerCaCertificate = "signer/ca.pem"
 
+const (
+	SignatureAnnotation = "marketplace.redhat.com/signature"
+	PublicKeyAnnotation = "marketplace.redhat.com/publickey"
+)
+
 func MustAssetReader(asset string) io.Reader {
 	return bytes.NewReader(MustAsset(asset))
 }
@@ -150,6 +156,51 @@ func UnstructuredToGVKSpecBytes(uobj unstructured.Unstructured) ([]byte, error)
 	return bytes, nil
 }
 
+// UnstructuredToObjectBytes returns the bytes of the whole object without
+// its signature annotations, for content whose every field is applied, like
+// remote resources.
+func UnstructuredToObjectBytes(uobj unstructured.Unstructured) ([]byte, error) {
+	obj := uobj.DeepCopy()
+
+	annotations := obj.GetAnnotations()
+	delete(annotations, SignatureAnnotation)
+	delete(annotations, PublicKeyAnnotation)
+
+	// objects without other annotations are signed before they are annotated
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+
+	return obj.MarshalJSON()
+}
+
+// SignObject annotates the object with the signature of the whole object
+// and the public key certificate verifying it.
+func SignObject(uobj *unstructured.Unstructured, pubKey []byte, privKey *rsa.PrivateKey) error {
+	bytes, err := UnstructuredToObjectBytes(*uobj)
+	if err != nil {
+		return errors.Wrap(err, "could not MarshalJSON")
+	}
+	hash := sha256.Sum256(bytes)
+
+	signature, err := rsa.SignPSS(rand.Reader, privKey, crypto.SHA256, hash[:], nil)
+	if err != nil {
+		return errors.Wrap(err, "could not sign")
+	}
+
+	annotations := uobj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[SignatureAnnotation] = hex.EncodeToString(signature)
+	annotations[PublicKeyAnnotation] = string(pubKey)
+	uobj.SetAnnotations(annotations)
+
+	return nil
+}
+
 func IsInputFromPipe() bool {
 	info, _ := os.Stdin.Stat()
 	return info.Mode()&os.ModeCharDevice == 0
@@ -186,48 +237,67 @@ func VerifyCert(root, signed *x509.Certificate) error {
 	return nil
 }
 
+// VerifySignatureArray verifies every object is signed, an error is
+// returned for the first one failing verification.
 func VerifySignatureArray(uobjs []unstructured.Unstructured, caCert *x509.Certificate) error {
+	return verifySignatures(uobjs, caCert, UnstructuredToGVKSpecBytes)
+}
+
+func VerifySignature(uobj unstructured.Unstructured, caCert *x509.Certificate) error {
+	return verifySignature(uobj, caCert, UnstructuredToGVKSpecBytes)
+}
+
+// VerifyObjectSignatureArray verifies the objects are signed as a whole by
+// SignObject, so none of their fields can be changed.
+func VerifyObjectSignatureArray(uobjs []unstructured.Unstructured, caCert *x509.Certificate) error {
+	return verifySignatures(uobjs, caCert, UnstructuredToObjectBytes)
+}
+
+type signedBytesFunc func(unstructured.Unstructured) ([]byte, error)
+
+func verifySignatures(uobjs []unstructured.Unstructured, caCert *x509.Certificate, signedBytes signedBytesFunc) error {
 	for _, uobj := range uobjs {
-		return VerifySignature(uobj, caCert)
+		if err := verifySignature(uobj, caCert, signedBytes); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func VerifySignature(uobj unstructured.Unstructured, caCert *x509.Certificate) error {
+func verifySignature(uobj unstructured.Unstructured, caCert *x509.Certificate, signedBytes signedBytesFunc) error {
 	//The Unstructured object could be an UnstructuredList
 	if uobj.IsList() {
 		uobjList, err := uobj.ToList()
 		if err != nil {
 			return err
 		}
-		return VerifySignatureArray(uobjList.Items, caCert)
+		return verifySignatures(uobjList.Items, caCert, signedBytes)
 	} else {
 		annotations := uobj.GetAnnotations()
 
 		// verify pubCert against caCert
 
-		pubKeyBytes := []byte(annotations["marketplace.redhat.com/publickey"])
+		pubKeyBytes := []byte(annotations[PublicKeyAnnotation])
 
 		pubCert, err := CertificateFromPemBytes(pubKeyBytes)
 		if err != nil {
 			return errors.Wrap(err, "public key annotation is malformed")
 		}
 
-		VerifyCert(caCert, pubCert)
+		err = VerifyCert(caCert, pubCert)
 		if err != nil {
 			return errors.Wrap(err, "failed to verify public certificate against ca certificate")
 		}
 
 		// verify content & signature
 
-		// Reduce Object to GVK+Spec
-		bytes, err := UnstructuredToGVKSpecBytes(uobj)
+		bytes, err := signedBytes(uobj)
 		if err != nil {
 			return errors.Wrap(err, "could not MarshalJSON")
 		}
 		hash := sha256.Sum256(bytes)
 
-		signaturestring := annotations["marketplace.redhat.com/signature"]
+		signaturestring := annotations[SignatureAnnotation]
 		signaturehex, err := hex.DecodeString(signaturestring)
 		if err != nil {
 			return errors.Wrap(err, "signature is malformed, can not hex decode")
@@ -237,7 +307,7 @@ func VerifySignature(uobj unstructured.Unstructured, caCert *x509.Certificate) e
 		var ok bool
 		rsaPublicKey, ok = pubCert.PublicKey.(*rsa.PublicKey)
 		if !ok {
-			return errors.Wrap(err, "Unable to parse public key")
+			return errors.New("Unable to parse public key")
 		}
 
 		err = rsa.VerifyPSS(rsaPublicKey, crypto.SHA256, hash[:], signaturehex, nil)
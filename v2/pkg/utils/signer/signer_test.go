@@ -0,0 +1,137 @@
+// Copyright 2021 IBM Corp.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signer
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// newCertificate returns a certificate signed by the parent, self signed
+// without one.
+func newCertificate(name string, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).To(Succeed())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	Expect(err).To(Succeed())
+
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).To(Succeed())
+
+	return cert, key
+}
+
+// sign annotates the object with the signature of its GVK and spec.
+func sign(uobj unstructured.Unstructured, cert *x509.Certificate, key *rsa.PrivateKey) unstructured.Unstructured {
+	bytes, err := UnstructuredToGVKSpecBytes(uobj)
+	Expect(err).To(Succeed())
+	hash := sha256.Sum256(bytes)
+
+	signature, err := rsa.SignPSS(rand.Reader, key, crypto.SHA256, hash[:], nil)
+	Expect(err).To(Succeed())
+
+	uobj.SetAnnotations(map[string]string{
+		"marketplace.redhat.com/signature": hex.EncodeToString(signature),
+		"marketplace.redhat.com/publickey": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})),
+	})
+
+	return uobj
+}
+
+func meterDefinition(name string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "marketplace.redhat.com/v1beta1",
+		"kind":       "MeterDefinition",
+		"metadata":   map[string]interface{}{"name": name},
+		"spec":       map[string]interface{}{"group": "example.com", "kind": name},
+	}}
+}
+
+var _ = Describe("Signer", func() {
+	var (
+		caCert *x509.Certificate
+		cert   *x509.Certificate
+		key    *rsa.PrivateKey
+	)
+
+	BeforeEach(func() {
+		var caKey *rsa.PrivateKey
+		caCert, caKey = newCertificate("ca", nil, nil)
+		cert, key = newCertificate("signer", caCert, caKey)
+	})
+
+	It("should verify every signed object", func() {
+		uobjs := []unstructured.Unstructured{
+			sign(meterDefinition("a"), cert, key),
+			sign(meterDefinition("b"), cert, key),
+		}
+		Expect(VerifySignatureArray(uobjs, caCert)).To(Succeed())
+
+		uobjs[1].Object["spec"] = map[string]interface{}{"group": "example.com", "kind": "changed"}
+		Expect(VerifySignatureArray(uobjs, caCert)).ToNot(Succeed())
+	})
+
+	It("should verify every item of a list", func() {
+		list := &unstructured.UnstructuredList{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "List",
+		}}
+		list.Items = []unstructured.Unstructured{
+			sign(meterDefinition("a"), cert, key),
+			meterDefinition("b"),
+		}
+
+		content, err := list.MarshalJSON()
+		Expect(err).To(Succeed())
+
+		uobj := unstructured.Unstructured{}
+		Expect(uobj.UnmarshalJSON(content)).To(Succeed())
+		Expect(VerifySignature(uobj, caCert)).ToNot(Succeed())
+	})
+
+	It("should refuse objects signed by a certificate of another ca", func() {
+		otherCert, otherKey := newCertificate("other", nil, nil)
+
+		uobjs := []unstructured.Unstructured{sign(meterDefinition("a"), otherCert, otherKey)}
+		Expect(VerifySignatureArray(uobjs, caCert)).ToNot(Succeed())
+	})
+})
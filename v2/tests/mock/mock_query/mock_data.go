@@ -149,3 +149,35 @@ func MockResponseRoundTripper(file string, meterdefinitions []v1beta1.MeterDefin
 		}
 	}
 }
+
+// MockCountResponseRoundTripper responds to instant queries with a single
+// sample, as returned by a count() query.
+func MockCountResponseRoundTripper(count string) RoundTripFunc {
+	return func(req *http.Request) *http.Response {
+		headers := make(http.Header)
+		headers.Add("content-type", "application/json")
+
+		Expect(req.URL.String()).To(Equal("http://localhost:9090/api/v1/query"), "url does not match expected")
+
+		data := map[string]interface{}{
+			"status": "success",
+			"data": map[string]interface{}{
+				"resultType": "vector",
+				"result": []map[string]interface{}{
+					{
+						"metric": map[string]string{},
+						"value":  []interface{}{1, count},
+					},
+				},
+			},
+		}
+
+		bytes, _ := json.Marshal(&data)
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(strings.NewReader(string(bytes))),
+			Header:     headers,
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"emperror.dev/errors"
+	"github.com/redhat-marketplace/redhat-marketplace-operator/v2/pkg/transport"
 )
 
 // client
@@ -53,16 +54,26 @@ func (h withHeader) RoundTrip(req *http.Request) (*http.Response, error) {
 	return h.rt.RoundTrip(req)
 }
 
-func NewConnectClient(token string) *connectClient {
-	client := http.DefaultClient
-	rt := WithHeader(client.Transport)
+// NewConnectClient returns a client of the connect api using the outbound
+// transport set in the environment.
+func NewConnectClient(token string) (*connectClient, error) {
+	outbound, err := transport.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := outbound.NewTransport()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create transport")
+	}
+
+	rt := WithHeader(base)
 	rt.Add("Content-Type", "application/json")
 	rt.Add("Authorization", fmt.Sprintf("Bearer %s", token))
-	client.Transport = rt
 
 	return &connectClient{
-		Client: client,
-	}
+		Client: &http.Client{Transport: rt},
+	}, nil
 }
 
 type scanResults struct {
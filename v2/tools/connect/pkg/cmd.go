@@ -46,7 +46,11 @@ var WaitAndPublishCmd = &cobra.Command{
 		defer cancel()
 
 		results := newContainerResults(pidsToDigest)
-		client := NewConnectClient(token)
+		client, err := NewConnectClient(token)
+		if err != nil {
+			return err
+		}
+
 		ticker := time.NewTicker(30 * time.Second)
 		defer ticker.Stop()
 